@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Site represents a single place in the source code where an error code is
+// used or declared.
+type Site struct {
+	Code    string // The error code.
+	Domain  string // The error domain, empty when unknown.
+	Message string // The error message, empty when unknown.
+	Doc     string // The doc comment.
+	Decl    bool   // The site is the code constant declaration.
+	File    string // The slash separated path relative to the module root.
+	Line    int    // The line number.
+}
+
+// Location returns the site location in the "file:line" form.
+func (s Site) Location() string { return fmt.Sprintf("%s:%d", s.File, s.Line) }
+
+// Entry represents the catalog entry for an error code.
+type Entry struct {
+	Code      string   `json:"code"`
+	Domain    string   `json:"domain,omitempty"`
+	Message   string   `json:"message,omitempty"`
+	Doc       string   `json:"doc,omitempty"`
+	Locations []string `json:"locations"`
+}
+
+// Conflict represents an error code used with different messages.
+type Conflict struct {
+	Code  string // The error code.
+	Sites []Site // The sites with messages, the first message wins.
+}
+
+func (c Conflict) String() string {
+	msgs := make([]string, len(c.Sites))
+	for i, s := range c.Sites {
+		msgs[i] = fmt.Sprintf("%q (%s)", s.Message, s.Location())
+	}
+	return fmt.Sprintf(
+		"code %q has different messages: %s",
+		c.Code,
+		strings.Join(msgs, ", "),
+	)
+}
+
+// Catalog represents a catalog of error codes.
+type Catalog struct {
+	entries   []Entry
+	conflicts []Conflict
+}
+
+// NewCatalog returns a new [Catalog] built from the sites. The entry fields
+// are set from the first site, in the source order, with a non-empty value.
+// The doc comments of code constant declarations take precedence over the doc
+// comments of the other sites.
+func NewCatalog(sites []Site) *Catalog {
+	sites = slices.Clone(sites)
+	slices.SortStableFunc(sites, func(a, b Site) int {
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line))
+	})
+
+	idx := make(map[string]int)
+	decl := make(map[string]bool)
+	msgs := make(map[string][]Site)
+	cat := &Catalog{}
+	for _, s := range sites {
+		i, ok := idx[s.Code]
+		if !ok {
+			i = len(cat.entries)
+			idx[s.Code] = i
+			cat.entries = append(cat.entries, Entry{Code: s.Code})
+		}
+		e := &cat.entries[i]
+		if e.Domain == "" {
+			e.Domain = s.Domain
+		}
+		if e.Message == "" {
+			e.Message = s.Message
+		}
+		if s.Doc != "" && (e.Doc == "" || s.Decl && !decl[s.Code]) {
+			e.Doc = s.Doc
+			decl[s.Code] = s.Decl
+		}
+		loc := s.Location()
+		if !slices.Contains(e.Locations, loc) {
+			e.Locations = append(e.Locations, loc)
+		}
+		if s.Message != "" && !slices.ContainsFunc(msgs[s.Code], func(x Site) bool {
+			return x.Message == s.Message
+		}) {
+			msgs[s.Code] = append(msgs[s.Code], s)
+		}
+	}
+	slices.SortFunc(cat.entries, func(a, b Entry) int {
+		return cmp.Compare(a.Code, b.Code)
+	})
+	for _, e := range cat.entries {
+		if len(msgs[e.Code]) > 1 {
+			cat.conflicts = append(cat.conflicts, Conflict{
+				Code:  e.Code,
+				Sites: msgs[e.Code],
+			})
+		}
+	}
+	return cat
+}
+
+// Entries returns the catalog entries sorted by code.
+func (cat *Catalog) Entries() []Entry { return cat.entries }
+
+// Conflicts returns the codes used with different messages sorted by code.
+func (cat *Catalog) Conflicts() []Conflict { return cat.conflicts }
+
+// JSON returns the JSON representation of the catalog entries.
+func (cat *Catalog) JSON() ([]byte, error) {
+	entries := cat.entries
+	if entries == nil {
+		entries = []Entry{}
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// Markdown returns the Markdown representation of the catalog entries.
+func (cat *Catalog) Markdown() string {
+	var b strings.Builder
+	b.WriteString("# Error Codes\n\n")
+	b.WriteString("| Code | Domain | Message | Description | Locations |\n")
+	b.WriteString("|------|--------|---------|-------------|-----------|\n")
+	for _, e := range cat.entries {
+		locs := make([]string, len(e.Locations))
+		for i, loc := range e.Locations {
+			locs[i] = "`" + loc + "`"
+		}
+		_, _ = fmt.Fprintf(
+			&b,
+			"| `%s` | %s | %s | %s | %s |\n",
+			e.Code,
+			mdCell(e.Domain),
+			mdCell(e.Message),
+			mdCell(e.Doc),
+			strings.Join(locs, "<br>"),
+		)
+	}
+	return b.String()
+}
+
+// mdCell returns s formatted for use as the Markdown table cell.
+func mdCell(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_Site_Location(t *testing.T) {
+	// --- Given ---
+	s := Site{File: "pkg/file.go", Line: 12}
+
+	// --- When ---
+	have := s.Location()
+
+	// --- Then ---
+	assert.Equal(t, "pkg/file.go:12", have)
+}
+
+func Test_Conflict_String(t *testing.T) {
+	// --- Given ---
+	c := Conflict{
+		Code: "EC1",
+		Sites: []Site{
+			{Message: "a", File: "a.go", Line: 1},
+			{Message: "b", File: "b.go", Line: 2},
+		},
+	}
+
+	// --- When ---
+	have := c.String()
+
+	// --- Then ---
+	want := `code "EC1" has different messages: "a" (a.go:1), "b" (b.go:2)`
+	assert.Equal(t, want, have)
+}
+
+func Test_NewCatalog(t *testing.T) {
+	t.Run("no sites", func(t *testing.T) {
+		// --- When ---
+		have := NewCatalog(nil)
+
+		// --- Then ---
+		assert.Nil(t, have.Entries())
+		assert.Nil(t, have.Conflicts())
+	})
+
+	t.Run("entries sorted by code", func(t *testing.T) {
+		// --- Given ---
+		sites := []Site{
+			{Code: "EC2", File: "a.go", Line: 1},
+			{Code: "EC1", File: "a.go", Line: 2},
+		}
+
+		// --- When ---
+		have := NewCatalog(sites)
+
+		// --- Then ---
+		want := []Entry{
+			{Code: "EC1", Locations: []string{"a.go:2"}},
+			{Code: "EC2", Locations: []string{"a.go:1"}},
+		}
+		assert.Equal(t, want, have.Entries())
+	})
+
+	t.Run("first non-empty values in source order", func(t *testing.T) {
+		// --- Given ---
+		sites := []Site{
+			{Code: "EC1", Domain: "d2", Message: "m", File: "b.go", Line: 1},
+			{Code: "EC1", Domain: "d1", Doc: "doc", File: "a.go", Line: 9},
+			{Code: "EC1", File: "a.go", Line: 10},
+		}
+
+		// --- When ---
+		have := NewCatalog(sites)
+
+		// --- Then ---
+		want := []Entry{{
+			Code:      "EC1",
+			Domain:    "d1",
+			Message:   "m",
+			Doc:       "doc",
+			Locations: []string{"a.go:9", "a.go:10", "b.go:1"},
+		}}
+		assert.Equal(t, want, have.Entries())
+		assert.Nil(t, have.Conflicts())
+	})
+
+	t.Run("declaration doc takes precedence", func(t *testing.T) {
+		// --- Given ---
+		sites := []Site{
+			{Code: "EC1", Doc: "var doc", File: "a.go", Line: 1},
+			{Code: "EC1", Doc: "const doc", Decl: true, File: "b.go", Line: 1},
+			{Code: "EC1", Doc: "other doc", Decl: true, File: "c.go", Line: 1},
+		}
+
+		// --- When ---
+		have := NewCatalog(sites)
+
+		// --- Then ---
+		assert.Equal(t, "const doc", have.Entries()[0].Doc)
+	})
+
+	t.Run("same location is listed once", func(t *testing.T) {
+		// --- Given ---
+		sites := []Site{
+			{Code: "EC1", File: "a.go", Line: 1},
+			{Code: "EC1", File: "a.go", Line: 1},
+		}
+
+		// --- When ---
+		have := NewCatalog(sites)
+
+		// --- Then ---
+		assert.Equal(t, []string{"a.go:1"}, have.Entries()[0].Locations)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		// --- Given ---
+		sites := []Site{
+			{Code: "EC2", Message: "a", File: "a.go", Line: 1},
+			{Code: "EC2", Message: "b", File: "a.go", Line: 2},
+			{Code: "EC2", Message: "a", File: "a.go", Line: 3},
+			{Code: "EC1", Message: "c", File: "b.go", Line: 1},
+			{Code: "EC1", File: "b.go", Line: 2},
+		}
+
+		// --- When ---
+		have := NewCatalog(sites)
+
+		// --- Then ---
+		want := []Conflict{{
+			Code: "EC2",
+			Sites: []Site{
+				{Code: "EC2", Message: "a", File: "a.go", Line: 1},
+				{Code: "EC2", Message: "b", File: "a.go", Line: 2},
+			},
+		}}
+		assert.Equal(t, want, have.Conflicts())
+	})
+}
+
+func Test_Catalog_JSON(t *testing.T) {
+	t.Run("entries", func(t *testing.T) {
+		// --- Given ---
+		cat := NewCatalog([]Site{
+			{Code: "EC1", Domain: "d", Message: "m", File: "a.go", Line: 1},
+			{Code: "EC2", File: "a.go", Line: 2},
+		})
+
+		// --- When ---
+		have, err := cat.JSON()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `[
+			{"code": "EC1", "domain": "d", "message": "m", "locations": ["a.go:1"]},
+			{"code": "EC2", "locations": ["a.go:2"]}
+		]`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("no entries", func(t *testing.T) {
+		// --- Given ---
+		cat := NewCatalog(nil)
+
+		// --- When ---
+		have, err := cat.JSON()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "[]\n", string(have))
+	})
+}
+
+func Test_Catalog_Markdown(t *testing.T) {
+	// --- Given ---
+	cat := NewCatalog([]Site{
+		{Code: "EC1", Domain: "d", Message: "m", Doc: "Doc\nline.", File: "a.go", Line: 1},
+		{Code: "EC1", File: "b.go", Line: 2},
+		{Code: "EC2", File: "a.go", Line: 3},
+	})
+
+	// --- When ---
+	have := cat.Markdown()
+
+	// --- Then ---
+	want := "" +
+		"# Error Codes\n" +
+		"\n" +
+		"| Code | Domain | Message | Description | Locations |\n" +
+		"|------|--------|---------|-------------|-----------|\n" +
+		"| `EC1` | d | m | Doc line. | `a.go:1`<br>`b.go:2` |\n" +
+		"| `EC2` |  |  |  | `a.go:3` |\n"
+	assert.Equal(t, want, have)
+}
+
+func Test_mdCell_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"text", "abc", "abc"},
+		{"whitespace", " a\n\tb  c ", "a b c"},
+		{"pipe", "a|b", `a\|b`},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := mdCell(tc.s)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
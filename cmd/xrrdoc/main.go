@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Command xrrdoc generates a catalog of the error codes used in a Go module.
+//
+// It parses the module sources (test files, testdata and vendor directories
+// are skipped) and finds the error codes used with the xrr package:
+//   - calls to New and to the constructors returned by ErrorFunc,
+//   - WithCode options and SetCode calls,
+//   - string constants with names starting with "EC".
+//
+// For every code the catalog lists its domain, default message, source
+// locations, and the doc comment of the code constant or the variable the
+// error is assigned to.
+//
+// Usage:
+//
+//	xrrdoc [-md file] [-json file] [dir]
+//
+// The dir is the module root directory (containing the go.mod file) and
+// defaults to the current directory. When neither -md nor -json is given, the
+// Markdown catalog is written to the standard output. The command exits with
+// a non-zero code when two different messages share an error code.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command with the arguments and returns the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("xrrdoc", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	mdPth := fs.String("md", "", "write the Markdown catalog to the `file`")
+	jsonPth := fs.String("json", "", "write the JSON catalog to the `file`")
+	fs.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "usage: xrrdoc [-md file] [-json file] [dir]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() > 1 {
+		fs.Usage()
+		return 2
+	}
+	root := "."
+	if fs.NArg() == 1 {
+		root = fs.Arg(0)
+	}
+
+	sites, err := Scan(root)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "xrrdoc: %v\n", err)
+		return 1
+	}
+	cat := NewCatalog(sites)
+
+	if *mdPth == "" && *jsonPth == "" {
+		if _, err = io.WriteString(stdout, cat.Markdown()); err != nil {
+			_, _ = fmt.Fprintf(stderr, "xrrdoc: %v\n", err)
+			return 1
+		}
+	}
+	if *mdPth != "" {
+		if err = os.WriteFile(*mdPth, []byte(cat.Markdown()), 0o644); err != nil {
+			_, _ = fmt.Fprintf(stderr, "xrrdoc: %v\n", err)
+			return 1
+		}
+	}
+	if *jsonPth != "" {
+		data, err := cat.JSON()
+		if err == nil {
+			err = os.WriteFile(*jsonPth, data, 0o644)
+		}
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "xrrdoc: %v\n", err)
+			return 1
+		}
+	}
+
+	if conflicts := cat.Conflicts(); len(conflicts) > 0 {
+		for _, c := range conflicts {
+			_, _ = fmt.Fprintf(stderr, "xrrdoc: %s\n", c)
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_run(t *testing.T) {
+	t.Run("markdown to stdout", func(t *testing.T) {
+		// --- Given ---
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"testdata/mod"}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+		assert.Contain(t, "| `ECCharge` | payment | charge failed |", stdout.String())
+		assert.Equal(t, "", stderr.String())
+	})
+
+	t.Run("markdown and JSON files", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		md := filepath.Join(dir, "codes.md")
+		js := filepath.Join(dir, "codes.json")
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-md", md, "-json", js, "testdata/mod"}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+		assert.Equal(t, "", stdout.String())
+		assert.Equal(t, "", stderr.String())
+		assert.FileContain(t, "# Error Codes", md)
+		assert.FileContain(t, `"code": "ECCharge"`, js)
+	})
+
+	t.Run("conflicting messages", func(t *testing.T) {
+		// --- Given ---
+		js := filepath.Join(t.TempDir(), "codes.json")
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-json", js, "testdata/conflict"}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+		want := "xrrdoc: code \"ECConflict\" has different messages: " +
+			"\"message a\" (conflict.go:8), \"message b\" (conflict.go:9)\n"
+		assert.Equal(t, want, stderr.String())
+		assert.FileContain(t, `"code": "ECConflict"`, js)
+	})
+
+	t.Run("module codes without conflicts", func(t *testing.T) {
+		// --- Given ---
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"../.."}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+		assert.Contain(t, "| `ECPanic` | xrrhttp.edHTTP |", stdout.String())
+		assert.Equal(t, "", stderr.String())
+	})
+
+	t.Run("error - scan", func(t *testing.T) {
+		// --- Given ---
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"testdata/nomod"}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+		assert.Contain(t, "xrrdoc: open testdata/nomod/go.mod", stderr.String())
+		assert.Equal(t, "", stdout.String())
+	})
+
+	t.Run("error - write file", func(t *testing.T) {
+		// --- Given ---
+		md := filepath.Join(t.TempDir(), "missing", "codes.md")
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-md", md, "testdata/mod"}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+		assert.Contain(t, "xrrdoc: open "+md, stderr.String())
+		_, err := os.Stat(md)
+		assert.ErrorIs(t, os.ErrNotExist, err)
+	})
+
+	t.Run("error - too many arguments", func(t *testing.T) {
+		// --- Given ---
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"a", "b"}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 2, have)
+		assert.Contain(t, "usage: xrrdoc", stderr.String())
+	})
+
+	t.Run("error - unknown flag", func(t *testing.T) {
+		// --- Given ---
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-unknown"}, stdout, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 2, have)
+		assert.Contain(t, "flag provided but not defined", stderr.String())
+	})
+}
@@ -0,0 +1,522 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// xrrPath is the import path of the xrr package.
+const xrrPath = "github.com/ctx42/xrr/pkg/xrr"
+
+// codePrefix is the name prefix of the error code constants.
+const codePrefix = "EC"
+
+// Scan parses the Go module in the root directory and returns the sites
+// where the error codes are used or declared.
+func Scan(root string) ([]Site, error) {
+	mod, err := modulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	s := &scanner{
+		fset: token.NewFileSet(),
+		pkgs: make(map[string]*pkg),
+	}
+	if err = s.parse(root, mod); err != nil {
+		return nil, err
+	}
+	// Constants may refer to constants declared later or in other packages,
+	// collect them until there are no new ones.
+	for n := -1; n != s.consts(); {
+		n = s.consts()
+		for _, p := range s.sorted() {
+			for _, f := range p.files {
+				s.collect(p, f)
+			}
+		}
+	}
+	for _, p := range s.sorted() {
+		for _, f := range p.files {
+			s.scan(p, f)
+		}
+	}
+	return s.sites, nil
+}
+
+// modulePath returns the module path declared in the go.mod file.
+func modulePath(pth string) (string, error) {
+	fil, err := os.Open(pth)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = fil.Close() }()
+
+	sc := bufio.NewScanner(fil)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if mod, ok := strings.CutPrefix(line, "module"); ok {
+			mod = strings.TrimSpace(mod)
+			if unq, err := strconv.Unquote(mod); err == nil {
+				mod = unq
+			}
+			if mod != "" {
+				return mod, nil
+			}
+		}
+	}
+	if err = sc.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s: missing module directive", pth)
+}
+
+// pkg represents a parsed package.
+type pkg struct {
+	path  string            // Import path.
+	name  string            // Package name.
+	files []*file           // Parsed files.
+	strs  map[string]strDef // String constants by name.
+	names map[string]string // Domain names by the marker type name.
+	funcs map[string]funcDef
+}
+
+// file represents a parsed file.
+type file struct {
+	rel     string            // Slash separated path relative to the root.
+	ast     *ast.File         // Parsed file.
+	imports map[string]string // Import paths by the local package name.
+}
+
+// strDef represents a string constant definition.
+type strDef struct {
+	value string
+	doc   string
+}
+
+// funcDef represents a variable holding the constructor returned by the
+// ErrorFunc function.
+type funcDef struct {
+	file   *file    // The file with the variable.
+	domain ast.Expr // The domain type argument.
+}
+
+// scanner represents the module scanner.
+type scanner struct {
+	fset  *token.FileSet
+	pkgs  map[string]*pkg // Packages by import path.
+	sites []Site
+}
+
+// parse parses the non-test Go files in the root directory tree.
+func (s *scanner) parse(root, mod string) error {
+	return filepath.WalkDir(root, func(pth string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if pth != root && (name == "testdata" || name == "vendor" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, pth)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		af, err := parser.ParseFile(s.fset, pth, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+
+		imp := mod
+		if dir := path.Dir(rel); dir != "." {
+			imp = mod + "/" + dir
+		}
+		p, ok := s.pkgs[imp]
+		if !ok {
+			p = &pkg{
+				path:  imp,
+				name:  af.Name.Name,
+				strs:  make(map[string]strDef),
+				names: make(map[string]string),
+				funcs: make(map[string]funcDef),
+			}
+			s.pkgs[imp] = p
+		}
+		f := &file{rel: rel, ast: af, imports: make(map[string]string)}
+		for _, spec := range af.Imports {
+			ip, _ := strconv.Unquote(spec.Path.Value)
+			local := path.Base(ip)
+			if spec.Name != nil {
+				local = spec.Name.Name
+			}
+			f.imports[local] = ip
+		}
+		p.files = append(p.files, f)
+		return nil
+	})
+}
+
+// consts returns the number of the collected string constants.
+func (s *scanner) consts() int {
+	var n int
+	for _, p := range s.pkgs {
+		n += len(p.strs)
+	}
+	return n
+}
+
+// sorted returns the parsed packages sorted by import path.
+func (s *scanner) sorted() []*pkg {
+	pkgs := make([]*pkg, 0, len(s.pkgs))
+	for _, p := range s.pkgs {
+		pkgs = append(pkgs, p)
+	}
+	slices.SortFunc(pkgs, func(a, b *pkg) int { return strings.Compare(a.path, b.path) })
+	return pkgs
+}
+
+// collect collects the string constants, domain names, and ErrorFunc
+// constructors defined in the file.
+func (s *scanner) collect(p *pkg, f *file) {
+	for _, decl := range f.ast.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range d.Specs {
+				vs := spec.(*ast.ValueSpec)
+				doc := specDoc(d, vs)
+				for i, name := range vs.Names {
+					if i >= len(vs.Values) {
+						break
+					}
+					if val, ok := s.str(p, f, vs.Values[i]); ok {
+						p.strs[name.Name] = strDef{value: val, doc: doc}
+					}
+				}
+			}
+
+		case *ast.FuncDecl:
+			if typ, name, ok := domainName(d); ok {
+				p.names[typ] = name
+			}
+		}
+	}
+
+	ast.Inspect(f.ast, func(n ast.Node) bool {
+		var names []*ast.Ident
+		var values []ast.Expr
+		switch x := n.(type) {
+		case *ast.ValueSpec:
+			names, values = x.Names, x.Values
+		case *ast.AssignStmt:
+			for _, lhs := range x.Lhs {
+				id, _ := lhs.(*ast.Ident)
+				names = append(names, id)
+			}
+			values = x.Rhs
+		default:
+			return true
+		}
+		if len(names) != len(values) {
+			return true
+		}
+		for i, id := range names {
+			call, ok := values[i].(*ast.CallExpr)
+			if id == nil || !ok || len(call.Args) > 0 {
+				continue
+			}
+			if fn, typ := generic(call.Fun); typ != nil && s.isXrr(p, f, fn, "ErrorFunc") {
+				p.funcs[id.Name] = funcDef{file: f, domain: typ}
+			}
+		}
+		return true
+	})
+}
+
+// scan adds the sites found in the file.
+func (s *scanner) scan(p *pkg, f *file) {
+	for _, decl := range f.ast.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range d.Specs {
+			for _, name := range spec.(*ast.ValueSpec).Names {
+				def, ok := p.strs[name.Name]
+				if !ok || !strings.HasPrefix(name.Name, codePrefix) {
+					continue
+				}
+				st := s.site(f, name, def.value)
+				st.Doc, st.Decl = def.doc, true
+				s.sites = append(s.sites, st)
+			}
+		}
+	}
+
+	docs := make(map[*ast.CallExpr]string)
+	done := make(map[*ast.CallExpr]bool)
+	ast.Inspect(f.ast, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.GenDecl:
+			for _, spec := range x.Specs {
+				vs, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				doc := specDoc(x, vs)
+				for _, val := range vs.Values {
+					if call, ok := val.(*ast.CallExpr); ok && doc != "" {
+						docs[call] = doc
+					}
+				}
+			}
+
+		case *ast.CallExpr:
+			if !done[x] {
+				s.call(p, f, x, docs[x], done)
+			}
+		}
+		return true
+	})
+}
+
+// call adds the sites for the function call. The calls to the WithCode
+// function handled as arguments are marked as done.
+func (s *scanner) call(p *pkg, f *file, call *ast.CallExpr, doc string, done map[*ast.CallExpr]bool) {
+	fn, typ := generic(call.Fun)
+	var domain string
+	switch {
+	case typ == nil && s.isXrr(p, f, fn, "New"):
+		domain = s.domain(p, f, nil)
+	case typ == nil && s.isFunc(p, fn):
+		def := p.funcs[fn.(*ast.Ident).Name]
+		domain = s.domain(p, def.file, def.domain)
+	case typ == nil && s.isXrr(p, f, fn, "Wrap"):
+		s.options(p, f, call.Args, s.domain(p, f, nil), "", done)
+		return
+	case typ != nil && s.isXrr(p, f, fn, "WrapUsing"):
+		s.options(p, f, call.Args, s.domain(p, f, typ), "", done)
+		return
+	case typ != nil && s.isXrr(p, f, fn, "SetCode"):
+		if len(call.Args) == 2 {
+			s.code(p, f, call.Args[1], s.domain(p, f, typ), "", "")
+		}
+		return
+	case typ == nil && s.isXrr(p, f, fn, "WithCode"):
+		if len(call.Args) == 1 {
+			s.code(p, f, call.Args[0], "", "", "")
+		}
+		return
+	default:
+		return
+	}
+
+	if len(call.Args) < 2 {
+		return
+	}
+	msg, _ := s.str(p, f, call.Args[0])
+	s.code(p, f, call.Args[1], domain, msg, doc)
+	s.options(p, f, call.Args[2:], domain, msg, done)
+}
+
+// options adds the sites for the WithCode calls in the arguments and marks
+// them as done.
+func (s *scanner) options(p *pkg, f *file, args []ast.Expr, domain, msg string, done map[*ast.CallExpr]bool) {
+	for _, arg := range args {
+		call, ok := arg.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 || !s.isXrr(p, f, call.Fun, "WithCode") {
+			continue
+		}
+		done[call] = true
+		s.code(p, f, call.Args[0], domain, msg, "")
+	}
+}
+
+// code adds the site for the code expression. Expressions which are not
+// string constants are ignored.
+func (s *scanner) code(p *pkg, f *file, expr ast.Expr, domain, msg, doc string) {
+	code, ok := s.str(p, f, expr)
+	if !ok || code == "" {
+		return
+	}
+	st := s.site(f, expr, code)
+	st.Domain, st.Message, st.Doc = domain, msg, doc
+	s.sites = append(s.sites, st)
+}
+
+// site returns the site for the code at the node position.
+func (s *scanner) site(f *file, node ast.Node, code string) Site {
+	return Site{
+		Code: code,
+		File: f.rel,
+		Line: s.fset.Position(node.Pos()).Line,
+	}
+}
+
+// str returns the value of the constant string expression. Supports string
+// literals, string constants defined in the scanned packages, and their
+// concatenations.
+func (s *scanner) str(p *pkg, f *file, expr ast.Expr) (string, bool) {
+	switch x := expr.(type) {
+	case *ast.BasicLit:
+		if x.Kind != token.STRING {
+			return "", false
+		}
+		val, err := strconv.Unquote(x.Value)
+		return val, err == nil
+
+	case *ast.ParenExpr:
+		return s.str(p, f, x.X)
+
+	case *ast.BinaryExpr:
+		if x.Op != token.ADD {
+			return "", false
+		}
+		l, lok := s.str(p, f, x.X)
+		r, rok := s.str(p, f, x.Y)
+		return l + r, lok && rok
+
+	case *ast.Ident:
+		def, ok := p.strs[x.Name]
+		return def.value, ok
+
+	case *ast.SelectorExpr:
+		id, ok := x.X.(*ast.Ident)
+		if !ok {
+			return "", false
+		}
+		if q := s.pkgs[f.imports[id.Name]]; q != nil {
+			def, ok := q.strs[x.Sel.Name]
+			return def.value, ok
+		}
+	}
+	return "", false
+}
+
+// domain returns the name of the domain for the type expression. The nil
+// expression represents the default xrr domain.
+func (s *scanner) domain(p *pkg, f *file, typ ast.Expr) string {
+	switch x := typ.(type) {
+	case nil:
+		return s.named(s.pkgs[xrrPath], "xrr", "EDXrr")
+	case *ast.Ident:
+		return s.named(p, p.name, x.Name)
+	case *ast.SelectorExpr:
+		id, ok := x.X.(*ast.Ident)
+		if !ok {
+			return ""
+		}
+		if q := s.pkgs[f.imports[id.Name]]; q != nil {
+			return s.named(q, q.name, x.Sel.Name)
+		}
+		return id.Name + "." + x.Sel.Name
+	}
+	return ""
+}
+
+// named returns the domain name of the marker type in the package. The name
+// is the name returned by its DomainName method or the qualified type name.
+func (s *scanner) named(p *pkg, pkgName, typ string) string {
+	if p != nil {
+		if name, ok := p.names[typ]; ok {
+			return name
+		}
+	}
+	return pkgName + "." + typ
+}
+
+// isXrr returns true if the expression refers to the xrr package function
+// with the name.
+func (s *scanner) isXrr(p *pkg, f *file, expr ast.Expr, name string) bool {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		return p.path == xrrPath && x.Name == name
+	case *ast.SelectorExpr:
+		id, ok := x.X.(*ast.Ident)
+		return ok && x.Sel.Name == name && f.imports[id.Name] == xrrPath
+	}
+	return false
+}
+
+// isFunc returns true if the expression refers to the variable holding the
+// constructor returned by the ErrorFunc function.
+func (s *scanner) isFunc(p *pkg, expr ast.Expr) bool {
+	id, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = p.funcs[id.Name]
+	return ok
+}
+
+// generic returns the function and its type argument for the instantiated
+// generic function expression. Returns nil type argument otherwise.
+func generic(expr ast.Expr) (ast.Expr, ast.Expr) {
+	if x, ok := expr.(*ast.IndexExpr); ok {
+		return x.X, x.Index
+	}
+	return expr, nil
+}
+
+// domainName returns the receiver type name and the domain name for the
+// DomainName method returning a string literal.
+func domainName(fd *ast.FuncDecl) (string, string, bool) {
+	if fd.Name.Name != "DomainName" || fd.Recv == nil || len(fd.Recv.List) != 1 {
+		return "", "", false
+	}
+	typ := fd.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	id, ok := typ.(*ast.Ident)
+	if !ok || fd.Body == nil || len(fd.Body.List) != 1 {
+		return "", "", false
+	}
+	ret, ok := fd.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", "", false
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", "", false
+	}
+	name, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", "", false
+	}
+	return id.Name, name, true
+}
+
+// specDoc returns the doc comment of the value specification. The doc
+// comment of the declaration is used for declarations with a single
+// specification.
+func specDoc(d *ast.GenDecl, vs *ast.ValueSpec) string {
+	doc := vs.Doc
+	if doc == nil && len(d.Specs) == 1 {
+		doc = d.Doc
+	}
+	return strings.TrimSpace(doc.Text())
+}
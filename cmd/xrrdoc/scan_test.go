@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_Scan(t *testing.T) {
+	t.Run("module", func(t *testing.T) {
+		// --- When ---
+		have, err := Scan("testdata/mod")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := []Site{
+			{
+				Code: "ECNotFound",
+				Doc:  "ECNotFound represents the not found error code.",
+				Decl: true,
+				File: "codes.go",
+				Line: 10,
+			},
+			{
+				Code: "DB.Timeout",
+				Doc:  "ECTimeout represents the timeout error code.",
+				Decl: true,
+				File: "codes.go",
+				Line: 13,
+			},
+			{
+				Code:    "ECNotFound",
+				Domain:  "xrr.EDXrr",
+				Message: "not found",
+				Doc:     "ErrNotFound represents the not found error.",
+				File:    "codes.go",
+				Line:    20,
+			},
+			{Code: "ECWrapped", Domain: "xrr.EDXrr", File: "codes.go", Line: 24},
+			{Code: "DB.Timeout", Domain: "xrr.EDXrr", File: "codes.go", Line: 25},
+			{Code: "ECOption", File: "codes.go", Line: 26},
+			{
+				Code:    "ECNotFound",
+				Domain:  "xrr.EDXrr",
+				Message: "not found",
+				File:    "codes.go",
+				Line:    27,
+			},
+			{
+				Code:    "ECOverride",
+				Domain:  "xrr.EDXrr",
+				Message: "not found",
+				File:    "codes.go",
+				Line:    27,
+			},
+			{
+				Code:    "ECCharge",
+				Domain:  "payment",
+				Message: "charge failed",
+				Doc:     "ErrCharge represents the charge error.",
+				File:    "payment/payment.go",
+				Line:    18,
+			},
+			{
+				Code:   "ECOther",
+				Domain: "payment.edOther",
+				File:   "payment/payment.go",
+				Line:   23,
+			},
+			{
+				Code:    "DB.Timeout",
+				Domain:  "payment.edOther",
+				Message: "other",
+				File:    "payment/payment.go",
+				Line:    24,
+			},
+			{
+				Code:    "ECNotFound",
+				Domain:  "payment",
+				Message: "not found",
+				File:    "payment/payment.go",
+				Line:    25,
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("error - not a module", func(t *testing.T) {
+		// --- When ---
+		have, err := Scan("testdata/nomod")
+
+		// --- Then ---
+		assert.ErrorIs(t, os.ErrNotExist, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid source", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		mod := []byte("module example.com/inv\n")
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), mod, 0o600))
+		src := []byte("package inv\n\nfunc {\n")
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "inv.go"), src, 0o600))
+
+		// --- When ---
+		have, err := Scan(dir)
+
+		// --- Then ---
+		assert.ErrorContain(t, "inv.go:3:6: expected 'IDENT'", err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_modulePath(t *testing.T) {
+	t.Run("module", func(t *testing.T) {
+		// --- When ---
+		have, err := modulePath("testdata/mod/go.mod")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "example.com/mod", have)
+	})
+
+	t.Run("quoted module path", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "go.mod")
+		assert.NoError(t, os.WriteFile(pth, []byte("module \"example.com/q\"\n"), 0o600))
+
+		// --- When ---
+		have, err := modulePath(pth)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "example.com/q", have)
+	})
+
+	t.Run("error - missing module directive", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "go.mod")
+		assert.NoError(t, os.WriteFile(pth, []byte("go 1.26\n"), 0o600))
+
+		// --- When ---
+		have, err := modulePath(pth)
+
+		// --- Then ---
+		assert.ErrorEqual(t, pth+": missing module directive", err)
+		assert.Equal(t, "", have)
+	})
+}
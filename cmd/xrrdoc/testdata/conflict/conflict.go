@@ -0,0 +1,11 @@
+package conflict
+
+import (
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+var (
+	errA = xrr.New("message a", "ECConflict")
+	errB = xrr.New("message b", "ECConflict")
+	errC = xrr.New("message a", "ECConflict")
+)
@@ -0,0 +1,28 @@
+package mod
+
+import (
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+// Error codes.
+const (
+	// ECNotFound represents the not found error code.
+	ECNotFound = "ECNotFound"
+
+	// ECTimeout represents the timeout error code.
+	ECTimeout = CatDB + ".Timeout"
+
+	// CatDB is not an error code constant.
+	CatDB = "DB"
+)
+
+// ErrNotFound represents the not found error.
+var ErrNotFound = xrr.New("not found", ECNotFound)
+
+// Find returns the not found error.
+func Find() error {
+	_ = xrr.Wrap(ErrNotFound, xrr.WithCode("ECWrapped"))
+	_ = xrr.SetCode[xrr.EDXrr](ErrNotFound, ECTimeout)
+	_ = xrr.WithCode("ECOption")
+	return xrr.New("not found", ECNotFound, xrr.WithCode("ECOverride"))
+}
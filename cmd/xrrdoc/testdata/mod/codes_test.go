@@ -0,0 +1,7 @@
+package mod
+
+import (
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+var errTest = xrr.New("test", "ECTest")
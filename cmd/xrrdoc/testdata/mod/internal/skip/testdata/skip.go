@@ -0,0 +1,7 @@
+package skip
+
+import (
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+var errSkip = xrr.New("skip", "ECSkip")
@@ -0,0 +1,26 @@
+package payment
+
+import (
+	x "github.com/ctx42/xrr/pkg/xrr"
+
+	"example.com/mod"
+)
+
+type edPayment struct{}
+
+func (edPayment) DomainName() string { return "payment" }
+
+type edOther struct{}
+
+var newError = x.ErrorFunc[edPayment]()
+
+// ErrCharge represents the charge error.
+var ErrCharge = newError("charge failed", "ECCharge")
+
+// Charge returns the charge error.
+func Charge() error {
+	newOther := x.ErrorFunc[edOther]()
+	_ = x.WrapUsing[edOther](ErrCharge, x.WithCode("ECOther"))
+	_ = newOther("other", mod.ECTimeout)
+	return newError("not found", mod.ECNotFound)
+}
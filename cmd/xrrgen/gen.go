@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// tpl is the template of the generated file.
+var tpl = template.Must(template.New("gen").Funcs(template.FuncMap{
+	"quote":   strconv.Quote,
+	"comment": comment,
+	"method":  func(typ string) string { return metaTypes[typ] },
+}).Parse(`// Code generated by xrrgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+{{- if .Time }}
+	"time"
+{{ end }}
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+// {{ .Marker }} is the {{ quote .Domain }} error domain marker.
+type {{ .Marker }} struct{}
+
+// DomainName returns the error domain name.
+func ({{ .Marker }}) DomainName() string { return {{ quote .Domain }} }
+
+// Error types in the {{ quote .Domain }} domain.
+type (
+	{{ .Name }}Error       = xrr.GenericError[{{ .Marker }}]
+	{{ .Name }}FieldsError = xrr.GenericFields[{{ .Marker }}]
+)
+
+// Error constructor functions for the {{ quote .Domain }} domain.
+var (
+	new{{ .Name }}Error       = xrr.ErrorFunc[{{ .Marker }}]()
+	new{{ .Name }}FieldsError = xrr.FieldsFunc[{{ .Marker }}]()
+)
+{{ if .Codes }}
+// Error codes.
+const (
+{{- range $i, $c := .Codes }}
+{{- if $i }}
+{{ end }}
+{{ comment "\t" (printf "EC%s represents the %q error code." $c.Name $c.Code) $c.Description }}
+	EC{{ $c.Name }} = {{ quote $c.Code }}
+{{- end }}
+)
+
+// Sentinel errors.
+var (
+{{- range $i, $c := .Codes }}
+{{- if $i }}
+{{ end }}
+{{ comment "\t" (printf "Err%s represents the error with the [EC%s] code." $c.Name $c.Name) }}
+	Err{{ $c.Name }} = new{{ $.Name }}Error({{ quote $c.Message }}, EC{{ $c.Name }})
+{{- end }}
+)
+{{ end }}
+{{ comment "" (printf "Is%sError returns true if err is an error in the %q domain." .Name .Domain) }}
+func Is{{ .Name }}Error(err error) bool {
+	return xrr.IsDomain[{{ .Marker }}](err)
+}
+
+{{ comment "" (printf "New%sFieldsError returns a new fields error in the %q domain." .Name .Domain) }}
+func New{{ .Name }}FieldsError(field string, err error) error {
+	return new{{ .Name }}FieldsError(field, err)
+}
+{{ range .Codes }}
+{{ comment "" (printf "New%sError returns a new error with the [EC%s] code and the default message.%s" .Name .Name (or (and .Meta " The required metadata is set from the parameters.") "")) }}
+{{- if .Meta }}
+func New{{ .Name }}Error(
+{{- range .Meta }}
+	{{ .Param }} {{ .Type }},
+{{- end }}
+	opts ...xrr.Option,
+) error {
+	meta := xrr.Meta()
+{{- range .Meta }}.
+		{{ method .Type }}({{ quote .Key }}, {{ .Param }}{{ if eq (printf "%.2s" .Type) "[]" }}...{{ end }})
+{{- end }}
+	opts = append([]xrr.Option{meta.Option()}, opts...)
+{{- else }}
+func New{{ .Name }}Error(opts ...xrr.Option) error {
+{{- end }}
+	return new{{ $.Name }}Error({{ quote .Message }}, EC{{ .Name }}, opts...)
+}
+{{ end }}
+func init() {
+	xrr.RegisterDomain[{{ .Marker }}]()
+{{- if .Codes }}
+	xrr.Register[{{ .Marker }}](
+{{- range .Codes }}
+		xrr.CodeInfo{
+			Code:        EC{{ .Name }},
+{{- if .Description }}
+			Description: {{ quote .Description }},
+{{- end }}
+			Message:     Err{{ .Name }}.Error(),
+{{- if .Status }}
+			Status:      {{ .Status }},
+{{- end }}
+{{- if .Retryable }}
+			Retryable:   true,
+{{- end }}
+{{- if .Public }}
+			Public:      true,
+{{- end }}
+		},
+{{- end }}
+	)
+	xrr.RegisterSentinel(
+{{- range .Codes }}
+		Err{{ .Name }},
+{{- end }}
+	)
+{{- end }}
+}
+`))
+
+// Generate returns the formatted Go source code for the specification.
+func Generate(spec *Spec) ([]byte, error) {
+	name := goName(spec.Domain)
+	data := struct {
+		*Spec
+		Name   string // Exported domain name used in identifiers.
+		Marker string // Domain marker type name.
+		Time   bool   // True when the time package is needed.
+	}{
+		Spec:   spec,
+		Name:   name,
+		Marker: "ed" + name,
+	}
+	for _, c := range spec.Codes {
+		for _, m := range c.Meta {
+			if strings.HasPrefix(m.Type, "time.") {
+				data.Time = true
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// comment returns the Go comment with the paragraphs indented with the
+// indent. The paragraphs are separated by an empty comment line and wrapped
+// at 80 columns. Empty paragraphs are skipped.
+func comment(indent string, paras ...string) string {
+	const width = 80
+	var lines []string
+	for _, para := range paras {
+		fields := strings.Fields(para)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(lines) > 0 {
+			lines = append(lines, indent+"//")
+		}
+		cur := indent + "//"
+		for _, word := range fields {
+			// Tabs are counted as four columns.
+			n := len(cur) + 3*strings.Count(indent, "\t")
+			if cur != indent+"//" && n+1+len(word) > width {
+				lines = append(lines, cur)
+				cur = indent + "//"
+			}
+			cur += " " + word
+		}
+		lines = append(lines, cur)
+	}
+	return strings.Join(lines, "\n")
+}
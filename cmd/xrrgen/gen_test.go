@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/goldy"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_Generate(t *testing.T) {
+	t.Run("codes", func(t *testing.T) {
+		// --- Given ---
+		spec := must.Value(LoadSpec("testdata/payment.json"))
+
+		// --- When ---
+		have, err := Generate(spec)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := goldy.Open(t, "testdata/payment.gld").String()
+		assert.Equal(t, want, string(have))
+	})
+
+	t.Run("no codes", func(t *testing.T) {
+		// --- Given ---
+		spec := &Spec{Package: "pkg", Domain: "my-domain"}
+
+		// --- When ---
+		have, err := Generate(spec)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Contain(t, "type edMyDomain struct{}", string(have))
+		assert.NotContain(t, "const (", string(have))
+		assert.NotContain(t, `"time"`, string(have))
+		assert.NotContain(t, "xrr.Register[", string(have))
+	})
+
+	t.Run("error - invalid code", func(t *testing.T) {
+		// --- Given ---
+		spec := &Spec{Package: "pkg", Domain: "dom", Codes: []Code{{Name: "A B"}}}
+
+		// --- When ---
+		have, err := Generate(spec)
+
+		// --- Then ---
+		assert.ErrorContain(t, "formatting generated code: ", err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_comment(t *testing.T) {
+	t.Run("single line", func(t *testing.T) {
+		// --- When ---
+		have := comment("", "Line  one.")
+
+		// --- Then ---
+		assert.Equal(t, "// Line one.", have)
+	})
+
+	t.Run("paragraphs", func(t *testing.T) {
+		// --- When ---
+		have := comment("\t", "Para one.", "", "Para two.")
+
+		// --- Then ---
+		assert.Equal(t, "\t// Para one.\n\t//\n\t// Para two.", have)
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		// --- Given ---
+		para := "aaaaaaaaa bbbbbbbbb ccccccccc ddddddddd eeeeeeeee " +
+			"fffffffff ggggggggg hhhhhhhhh"
+
+		// --- When ---
+		have := comment("", para)
+
+		// --- Then ---
+		want := "" +
+			"// aaaaaaaaa bbbbbbbbb ccccccccc ddddddddd eeeeeeeee fffffffff ggggggggg\n" +
+			"// hhhhhhhhh"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("no paragraphs", func(t *testing.T) {
+		// --- When ---
+		have := comment("\t")
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+}
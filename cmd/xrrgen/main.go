@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Command xrrgen generates the error domain boilerplate from a JSON spec.
+//
+// For the domain it generates the domain marker type (implementing the
+// xrr.DomainNamer interface), error type aliases, constructor functions
+// bound to the domain with xrr.ErrorFunc and xrr.FieldsFunc, and the
+// registration of the domain, codes and sentinel errors. For every code it
+// generates the code constant, the sentinel error and the typed constructor
+// function whose parameters are the required metadata.
+//
+// Usage:
+//
+//	xrrgen [-o file] spec.json
+//
+// The output file defaults to the spec file name with the ".json" extension
+// replaced by "_xrr.go". The command is meant to be used with go generate:
+//
+//	//go:generate go run github.com/ctx42/xrr/cmd/xrrgen errors.json
+//
+// Example spec:
+//
+//	{
+//	  "package": "payment",
+//	  "domain": "payment",
+//	  "codes": [
+//	    {
+//	      "name": "ChargeFailed",
+//	      "code": "EC_CHARGE_FAILED",
+//	      "message": "charge failed",
+//	      "description": "The payment provider declined the charge.",
+//	      "status": 402,
+//	      "public": true,
+//	      "meta": [{"key": "amount", "type": "int64"}]
+//	    }
+//	  ]
+//	}
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+// run runs the command with the arguments and returns the exit code.
+func run(args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("xrrgen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	out := fs.String("o", "", "write the generated code to the `file`")
+	fs.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "usage: xrrgen [-o file] spec.json")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	pth := fs.Arg(0)
+	if *out == "" {
+		*out = strings.TrimSuffix(pth, ".json") + "_xrr.go"
+	}
+
+	spec, err := LoadSpec(pth)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "xrrgen: %v\n", err)
+		return 1
+	}
+	src, err := Generate(spec)
+	if err == nil {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "xrrgen: %v\n", err)
+		return 1
+	}
+	return 0
+}
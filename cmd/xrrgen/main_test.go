@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/goldy"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_run(t *testing.T) {
+	t.Run("output file", func(t *testing.T) {
+		// --- Given ---
+		out := filepath.Join(t.TempDir(), "payment_xrr.go")
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-o", out, "testdata/payment.json"}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+		assert.Equal(t, "", stderr.String())
+		want := goldy.Open(t, "testdata/payment.gld").String()
+		assert.Equal(t, want, string(must.Value(os.ReadFile(out))))
+	})
+
+	t.Run("default output file", func(t *testing.T) {
+		// --- Given ---
+		dir := t.TempDir()
+		spec := filepath.Join(dir, "errors.json")
+		data := []byte(`{"package": "pkg", "domain": "dom"}`)
+		assert.NoError(t, os.WriteFile(spec, data, 0o600))
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{spec}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+		assert.Equal(t, "", stderr.String())
+		assert.FileExist(t, filepath.Join(dir, "errors_xrr.go"))
+	})
+
+	t.Run("error - invalid spec", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"testdata/missing.json"}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+		assert.Contain(t, "xrrgen: open testdata/missing.json", stderr.String())
+	})
+
+	t.Run("error - write file", func(t *testing.T) {
+		// --- Given ---
+		out := filepath.Join(t.TempDir(), "missing", "payment_xrr.go")
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-o", out, "testdata/payment.json"}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 1, have)
+		assert.Contain(t, "xrrgen: open "+out, stderr.String())
+	})
+
+	t.Run("error - missing spec argument", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run(nil, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 2, have)
+		assert.Contain(t, "usage: xrrgen", stderr.String())
+	})
+
+	t.Run("error - unknown flag", func(t *testing.T) {
+		// --- Given ---
+		stderr := &bytes.Buffer{}
+
+		// --- When ---
+		have := run([]string{"-unknown"}, stderr)
+
+		// --- Then ---
+		assert.Equal(t, 2, have)
+		assert.Contain(t, "flag provided but not defined", stderr.String())
+	})
+}
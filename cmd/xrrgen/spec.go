@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// metaTypes maps the supported metadata types to the names of the xrr
+// metadata builder methods.
+var metaTypes = map[string]string{
+	"bool":          "Bool",
+	"string":        "Str",
+	"int":           "Int",
+	"int64":         "Int64",
+	"uint64":        "Uint64",
+	"float64":       "Float64",
+	"time.Time":     "Time",
+	"time.Duration": "Duration",
+	"[]string":      "Strs",
+	"[]int":         "Ints",
+}
+
+// reserved are the identifiers used by the generated constructors which
+// cannot be used as parameter names.
+var reserved = map[string]bool{"opts": true, "meta": true, "xrr": true}
+
+// Spec represents the error domain specification.
+type Spec struct {
+	// Package is the name of the generated package.
+	Package string `json:"package"`
+
+	// Domain is the error domain name.
+	Domain string `json:"domain"`
+
+	// Codes are the error codes in the domain.
+	Codes []Code `json:"codes"`
+}
+
+// Code represents the error code specification.
+type Code struct {
+	// Name is the Go name used for the generated identifiers. For example,
+	// the "ChargeFailed" name generates the ECChargeFailed constant, the
+	// ErrChargeFailed sentinel error and the NewChargeFailedError function.
+	Name string `json:"name"`
+
+	// Code is the error code, it defaults to "EC" followed by the name.
+	Code string `json:"code"`
+
+	// Message is the default error message.
+	Message string `json:"message"`
+
+	// Description is the code description.
+	Description string `json:"description"`
+
+	// Status is the HTTP status code, zero when not specified.
+	Status int `json:"status"`
+
+	// Retryable is true if the failed operation may be retried.
+	Retryable bool `json:"retryable"`
+
+	// Public is true if the code may be exposed to clients.
+	Public bool `json:"public"`
+
+	// Meta are the metadata keys required by the typed constructor.
+	Meta []Meta `json:"meta"`
+}
+
+// Meta represents the required metadata key specification.
+type Meta struct {
+	// Key is the metadata key.
+	Key string `json:"key"`
+
+	// Type is the metadata value type, see [metaTypes] for supported types.
+	Type string `json:"type"`
+
+	// Param is the name of the constructor parameter, it defaults to the key
+	// converted to the lower camel case.
+	Param string `json:"param"`
+}
+
+// LoadSpec reads and validates the JSON specification file. The defaults are
+// applied to the returned specification.
+func LoadSpec(pth string) (*Spec, error) {
+	data, err := os.ReadFile(pth)
+	if err != nil {
+		return nil, err
+	}
+	spec := &Spec{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", pth, err)
+	}
+	if err = spec.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", pth, err)
+	}
+	return spec, nil
+}
+
+// Validate applies the defaults and validates the specification.
+func (spec *Spec) Validate() error {
+	var ers []error
+	if !token.IsIdentifier(spec.Package) {
+		ers = append(ers, fmt.Errorf("invalid package name: %q", spec.Package))
+	}
+	domain := goName(spec.Domain)
+	if domain == "" {
+		ers = append(ers, fmt.Errorf("invalid domain name: %q", spec.Domain))
+	}
+	idents := domainIdents(domain)
+	names := make(map[string]bool, len(spec.Codes))
+	codes := make(map[string]bool, len(spec.Codes))
+	for i := range spec.Codes {
+		c := &spec.Codes[i]
+		if c.Code == "" {
+			c.Code = "EC" + c.Name
+		}
+		if !token.IsIdentifier(c.Name) || !token.IsExported(c.Name) {
+			ers = append(ers, fmt.Errorf("codes[%d]: invalid name: %q", i, c.Name))
+		}
+		if names[c.Name] {
+			ers = append(ers, fmt.Errorf("codes[%d]: duplicate name: %q", i, c.Name))
+		}
+		if codes[c.Code] {
+			ers = append(ers, fmt.Errorf("codes[%d]: duplicate code: %q", i, c.Code))
+		}
+		for _, ident := range codeIdents(c.Name) {
+			if idents[ident] {
+				ers = append(ers, fmt.Errorf(
+					"codes[%d]: name %q collides with generated identifier: %q",
+					i, c.Name, ident,
+				))
+			}
+		}
+		names[c.Name], codes[c.Code] = true, true
+
+		params := make(map[string]bool, len(c.Meta))
+		for j := range c.Meta {
+			m := &c.Meta[j]
+			if m.Param == "" {
+				m.Param = paramName(m.Key)
+			}
+			if m.Key == "" {
+				ers = append(ers, fmt.Errorf("codes[%d].meta[%d]: empty key", i, j))
+			}
+			if _, ok := metaTypes[m.Type]; !ok {
+				ers = append(ers, fmt.Errorf(
+					"codes[%d].meta[%d]: unsupported type: %q", i, j, m.Type,
+				))
+			}
+			if !token.IsIdentifier(m.Param) || reserved[m.Param] {
+				ers = append(ers, fmt.Errorf(
+					"codes[%d].meta[%d]: invalid param name: %q", i, j, m.Param,
+				))
+			}
+			if params[m.Param] {
+				ers = append(ers, fmt.Errorf(
+					"codes[%d].meta[%d]: duplicate param name: %q", i, j, m.Param,
+				))
+			}
+			params[m.Param] = true
+		}
+	}
+	return errors.Join(ers...)
+}
+
+// domainIdents returns the exported identifiers generated for the domain
+// with the given Go name. Returns nil when the name is empty.
+func domainIdents(name string) map[string]bool {
+	if name == "" {
+		return nil
+	}
+	return map[string]bool{
+		name + "Error":               true,
+		name + "FieldsError":         true,
+		"Is" + name + "Error":        true,
+		"New" + name + "FieldsError": true,
+	}
+}
+
+// codeIdents returns the identifiers generated for the code with the given
+// name.
+func codeIdents(name string) []string {
+	return []string{"EC" + name, "Err" + name, "New" + name + "Error"}
+}
+
+// goName returns s converted to the exported Go name. Characters which are
+// not letters or digits separate words. Returns an empty string when s has
+// no letters or digits, or starts with a digit.
+func goName(s string) string {
+	var b strings.Builder
+	for _, word := range words(s) {
+		r := []rune(word)
+		b.WriteRune(unicode.ToUpper(r[0]))
+		b.WriteString(string(r[1:]))
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		return ""
+	}
+	return name
+}
+
+// paramName returns the key converted to the lower camel case Go name.
+// Returns an empty string when it is not possible.
+func paramName(key string) string {
+	name := goName(key)
+	if name == "" {
+		return ""
+	}
+	r := []rune(name)
+	r[0] = unicode.ToLower(r[0])
+	name = string(r)
+	if token.IsKeyword(name) {
+		name += "_"
+	}
+	return name
+}
+
+// words splits s into words separated by characters which are not letters
+// or digits.
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
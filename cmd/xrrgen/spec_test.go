@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_LoadSpec(t *testing.T) {
+	t.Run("spec with defaults", func(t *testing.T) {
+		// --- When ---
+		have, err := LoadSpec("testdata/payment.json")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "payment", have.Package)
+		assert.Equal(t, "payment", have.Domain)
+		assert.Len(t, 2, have.Codes)
+		assert.Equal(t, "EC_CHARGE_FAILED", have.Codes[0].Code)
+		assert.Equal(t, "validUntil", have.Codes[0].Meta[2].Param)
+		assert.Equal(t, "labels", have.Codes[0].Meta[3].Param)
+		assert.Equal(t, "ECTimeout", have.Codes[1].Code)
+	})
+
+	t.Run("error - missing file", func(t *testing.T) {
+		// --- When ---
+		have, err := LoadSpec("testdata/missing.json")
+
+		// --- Then ---
+		assert.ErrorIs(t, os.ErrNotExist, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - unknown field", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "spec.json")
+		data := []byte(`{"package": "pkg", "domain": "dom", "other": 1}`)
+		assert.NoError(t, os.WriteFile(pth, data, 0o600))
+
+		// --- When ---
+		have, err := LoadSpec(pth)
+
+		// --- Then ---
+		assert.ErrorEqual(t, pth+`: json: unknown field "other"`, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid spec", func(t *testing.T) {
+		// --- Given ---
+		pth := filepath.Join(t.TempDir(), "spec.json")
+		data := []byte(`{"package": "pkg", "domain": ""}`)
+		assert.NoError(t, os.WriteFile(pth, data, 0o600))
+
+		// --- When ---
+		have, err := LoadSpec(pth)
+
+		// --- Then ---
+		assert.ErrorEqual(t, pth+`: invalid domain name: ""`, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_Spec_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		spec := &Spec{
+			Package: "pkg",
+			Domain:  "dom",
+			Codes: []Code{
+				{Name: "A", Meta: []Meta{{Key: "type", Type: "int"}}},
+				{Name: "B", Code: "EC_B"},
+			},
+		}
+
+		// --- When ---
+		err := spec.Validate()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "ECA", spec.Codes[0].Code)
+		assert.Equal(t, "type_", spec.Codes[0].Meta[0].Param)
+		assert.Equal(t, "EC_B", spec.Codes[1].Code)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		// --- Given ---
+		spec := &Spec{
+			Package: "my-pkg",
+			Domain:  "1dom",
+			Codes: []Code{
+				{Name: "a"},
+				{Name: "B", Code: "ECa"},
+				{Name: "B"},
+				{Name: "C", Meta: []Meta{
+					{Key: "", Type: "int", Param: "p"},
+					{Key: "k", Type: "uint8", Param: "p"},
+					{Key: "meta", Type: "int"},
+				}},
+			},
+		}
+
+		// --- When ---
+		err := spec.Validate()
+
+		// --- Then ---
+		want := "" +
+			"invalid package name: \"my-pkg\"\n" +
+			"invalid domain name: \"1dom\"\n" +
+			"codes[0]: invalid name: \"a\"\n" +
+			"codes[1]: duplicate code: \"ECa\"\n" +
+			"codes[2]: duplicate name: \"B\"\n" +
+			"codes[3].meta[0]: empty key\n" +
+			"codes[3].meta[1]: unsupported type: \"uint8\"\n" +
+			"codes[3].meta[1]: duplicate param name: \"p\"\n" +
+			"codes[3].meta[2]: invalid param name: \"meta\""
+		assert.ErrorEqual(t, want, err)
+	})
+
+	t.Run("name collides with generated identifier", func(t *testing.T) {
+		// --- Given ---
+		spec := &Spec{
+			Package: "payment",
+			Domain:  "payment",
+			Codes:   []Code{{Name: "A"}, {Name: "PaymentFields"}},
+		}
+
+		// --- When ---
+		err := spec.Validate()
+
+		// --- Then ---
+		want := "codes[1]: name \"PaymentFields\" collides with generated " +
+			"identifier: \"NewPaymentFieldsError\""
+		assert.ErrorEqual(t, want, err)
+	})
+}
+
+func Test_goName_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"word", "payment", "Payment"},
+		{"separated words", "my-payment_domain.v2", "MyPaymentDomainV2"},
+		{"camel case", "myPayment", "MyPayment"},
+		{"starts with digit", "2fa", ""},
+		{"no letters", "--", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := goName(tc.s)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_paramName_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		key  string
+		want string
+	}{
+		{"empty", "", ""},
+		{"word", "amount", "amount"},
+		{"snake case", "valid_until", "validUntil"},
+		{"keyword", "type", "type_"},
+		{"starts with digit", "2fa", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := paramName(tc.key)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -33,6 +33,13 @@ type TErrMarshalJSON struct{ err error }
 func (tm *TErrMarshalJSON) Error() string                { return "test error" }
 func (tm *TErrMarshalJSON) MarshalJSON() ([]byte, error) { return nil, tm.err }
 
+// TErrMarshalJSONArray represents a test error struct implementing
+// [json.Marshaler] interface which returns the JSON array.
+type TErrMarshalJSONArray struct{}
+
+func (TErrMarshalJSONArray) Error() string                { return "test error" }
+func (TErrMarshalJSONArray) MarshalJSON() ([]byte, error) { return []byte(`[1]`), nil }
+
 // TMetaAll represents a struct implementing [Metadater] interface.
 type TMetaAll map[string]any
 
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"path"
+	"strings"
+)
+
+// CodeSep is the separator of hierarchical error code segments. For example,
+// the "DB.Conflict.Unique" code is in the "DB.Conflict" and "DB" categories.
+const CodeSep = "."
+
+// CodeCategory represents a category of hierarchical error codes.
+//
+//	const CatDB xrr.CodeCategory = "DB"
+//
+//	var ECTimeout = CatDB.Code("Timeout") // DB.Timeout
+type CodeCategory string
+
+// Code returns the error code for the name in the category.
+func (cat CodeCategory) Code(name string) string {
+	return string(cat) + CodeSep + name
+}
+
+// Sub returns the subcategory with the name.
+func (cat CodeCategory) Sub(name string) CodeCategory {
+	return CodeCategory(cat.Code(name))
+}
+
+// Contains returns true if the code is the category itself or is in the
+// category or one of its subcategories.
+func (cat CodeCategory) Contains(code string) bool {
+	if cat == "" {
+		return false
+	}
+	rest, ok := strings.CutPrefix(code, string(cat))
+	return ok && (rest == "" || strings.HasPrefix(rest, CodeSep))
+}
+
+// IsCodeUnder walks the error chain (tree) and returns true if any of the
+// errors has an error code in the given category. See [CodeCategory.Contains].
+func IsCodeUnder(err error, category CodeCategory) bool {
+	var is bool
+	cb := func(err error) bool {
+		if category.Contains(GetCode(err)) {
+			is = true
+			return false
+		}
+		return true
+	}
+	walk(err, cb)
+	return is
+}
+
+// IsCodeMatch walks the error chain (tree) and returns true if any of the
+// errors has an error code matching the pattern. See [CodeMatch].
+func IsCodeMatch(err error, pattern string) bool {
+	var is bool
+	cb := func(err error) bool {
+		if CodeMatch(pattern, GetCode(err)) {
+			is = true
+			return false
+		}
+		return true
+	}
+	walk(err, cb)
+	return is
+}
+
+// CodeMatch returns true if the code matches the glob-style pattern. The
+// pattern and the code are matched segment by segment (see [CodeSep]):
+//   - the "**" segment matches zero or more code segments,
+//   - other pattern segments are matched against a single code segment using
+//     the [path.Match] syntax, for example, "*" matches any segment and
+//     "Time*" segments starting with "Time".
+//
+// Examples:
+//
+//	CodeMatch("DB.*", "DB.Timeout")                // true
+//	CodeMatch("DB.*", "DB.Conflict.Unique")        // false
+//	CodeMatch("DB.**", "DB.Conflict.Unique")       // true
+//	CodeMatch("**.Unique", "DB.Conflict.Unique")   // true
+//	CodeMatch("ECGeneric", "ECGeneric")            // true
+//
+// Malformed patterns never match.
+func CodeMatch(pattern, code string) bool {
+	return matchSegments(
+		strings.Split(pattern, CodeSep),
+		strings.Split(code, CodeSep),
+	)
+}
+
+// matchCodes returns true if the code matches any of the patterns. Returns
+// true when there are no patterns.
+func matchCodes(code string, patterns ...string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if CodeMatch(pattern, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSegments returns true if the code segments match the pattern segments.
+// The "**" segments are matched with the two-pointer wildcard algorithm: on a
+// mismatch, the matching resumes after the last "**" segment with one more
+// code segment consumed by it, so it runs in O(len(pattern) * len(code)).
+func matchSegments(pattern, code []string) bool {
+	var p, c int
+	star, mark := -1, 0
+	for c < len(code) {
+		switch {
+		case p < len(pattern) && pattern[p] == "**":
+			star, mark = p, c
+			p++
+
+		case p < len(pattern) && matchSegment(pattern[p], code[c]):
+			p, c = p+1, c+1
+
+		case star >= 0:
+			mark++
+			p, c = star+1, mark
+
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == "**" {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// matchSegment returns true if the code segment matches the pattern segment
+// using the [path.Match] syntax. Malformed pattern segments never match.
+func matchSegment(pattern, code string) bool {
+	ok, _ := path.Match(pattern, code)
+	return ok
+}
@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_CodeCategory_Code(t *testing.T) {
+	// --- Given ---
+	cat := CodeCategory("DB")
+
+	// --- When ---
+	have := cat.Code("Timeout")
+
+	// --- Then ---
+	assert.Equal(t, "DB.Timeout", have)
+}
+
+func Test_CodeCategory_Sub(t *testing.T) {
+	// --- Given ---
+	cat := CodeCategory("DB")
+
+	// --- When ---
+	have := cat.Sub("Conflict")
+
+	// --- Then ---
+	assert.Equal(t, CodeCategory("DB.Conflict"), have)
+	assert.Equal(t, "DB.Conflict.Unique", have.Code("Unique"))
+}
+
+func Test_CodeCategory_Contains_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		cat  CodeCategory
+		code string
+		want bool
+	}{
+		{"category itself", "DB", "DB", true},
+		{"direct child", "DB", "DB.Timeout", true},
+		{"nested child", "DB", "DB.Conflict.Unique", true},
+		{"nested category", "DB.Conflict", "DB.Conflict.Unique", true},
+		{"same prefix", "DB", "DBX.Timeout", false},
+		{"parent", "DB.Conflict", "DB", false},
+		{"other", "DB", "HTTP.NotFound", false},
+		{"flat code", "DB", "ECGeneric", false},
+		{"empty code", "DB", "", false},
+		{"empty category", "", "DB", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.cat.Contains(tc.code)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_IsCodeUnder_tabular(t *testing.T) {
+	tree := func() error {
+		return fmt.Errorf("w: %w", errors.Join(
+			New("m0", "HTTP.NotFound"),
+			New("m1", "DB.Conflict.Unique"),
+		))
+	}
+
+	tt := []struct {
+		testN string
+
+		err  error
+		cat  CodeCategory
+		want bool
+	}{
+		{"nil error", nil, "DB", false},
+		{"top category", tree(), "DB", true},
+		{"nested category", tree(), "DB.Conflict", true},
+		{"other category", tree(), "Cache", false},
+		{"flat code", New("msg", "ECode"), "ECode", true},
+		{"flat code is not category of generic", errors.New("msg"), "EC", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := IsCodeUnder(tc.err, tc.cat)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_IsCodeMatch_tabular(t *testing.T) {
+	tree := func() error {
+		return fmt.Errorf("w: %w", errors.Join(
+			New("m0", "HTTP.NotFound"),
+			New("m1", "DB.Conflict.Unique"),
+		))
+	}
+
+	tt := []struct {
+		testN string
+
+		err     error
+		pattern string
+		want    bool
+	}{
+		{"nil error", nil, "**", false},
+		{"match", tree(), "DB.**", true},
+		{"match single segment", tree(), "HTTP.*", true},
+		{"no match", tree(), "DB.*", false},
+		{"generic", errors.New("msg"), "ECGeneric", true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := IsCodeMatch(tc.err, tc.pattern)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_CodeMatch_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pattern string
+		code    string
+		want    bool
+	}{
+		{"exact", "DB.Timeout", "DB.Timeout", true},
+		{"exact flat", "ECGeneric", "ECGeneric", true},
+		{"exact mismatch", "DB.Timeout", "DB.Conflict", false},
+		{"star", "DB.*", "DB.Timeout", true},
+		{"star does not match many", "DB.*", "DB.Conflict.Unique", false},
+		{"star does not match none", "DB.*", "DB", false},
+		{"star in the middle", "DB.*.Unique", "DB.Conflict.Unique", true},
+		{"partial segment", "DB.Time*", "DB.Timeout", true},
+		{"partial segment mismatch", "DB.Time*", "DB.Conflict", false},
+		{"double star many", "DB.**", "DB.Conflict.Unique", true},
+		{"double star none", "DB.**", "DB", true},
+		{"double star prefix", "**.Unique", "DB.Conflict.Unique", true},
+		{"double star prefix mismatch", "**.Unique", "DB.Conflict", false},
+		{"double star in the middle", "DB.**.Unique", "DB.A.B.Unique", true},
+		{"double star alone", "**", "DB.Timeout", true},
+		{"star does not match other category", "DB.*", "DBX.Timeout", false},
+		{"longer code", "DB", "DB.Timeout", false},
+		{"longer pattern", "DB.Timeout.X", "DB.Timeout", false},
+		{"malformed pattern", "DB.[", "DB.[", false},
+		{"malformed pattern after double star", "**.[", "DB.[", false},
+		{"double stars", "**.B.**.D", "A.B.C.B.D", true},
+		{"double stars backtrack", "**.B.*.D", "A.B.C.B.C.D", true},
+		{"double stars mismatch", "**.B.**.D", "A.B.C.B.E", false},
+		{"adjacent double stars", "DB.**.**", "DB", true},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := CodeMatch(tc.pattern, tc.code)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_CodeMatch(t *testing.T) {
+	t.Run("many double stars", func(t *testing.T) {
+		// --- Given ---
+		pattern := strings.Repeat("**.A.", 20) + "B"
+		code := strings.Repeat("A.", 60) + "C"
+
+		// --- When ---
+		have := CodeMatch(pattern, code)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_matchCodes(t *testing.T) {
+	t.Run("no patterns", func(t *testing.T) {
+		// --- When ---
+		have := matchCodes("ECode")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("any pattern matches", func(t *testing.T) {
+		// --- When ---
+		have := matchCodes("DB.Timeout", "HTTP.*", "DB.*")
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("no pattern matches", func(t *testing.T) {
+		// --- When ---
+		have := matchCodes("DB.Timeout", "HTTP.*")
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
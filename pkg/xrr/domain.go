@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+)
+
+// domains maps the names of the domains registered with [RegisterDomain] to
+// functions returning new instances of [GenericError] of the domain.
+var domains sync.Map
+
+// nodeDecoder represents an error which may be decoded from the JSON object
+// keys. See [GenericError.decode].
+type nodeDecoder interface {
+	DomainError
+	decode(m map[string]json.RawMessage, cause error) error
+}
+
+// RegisterDomain registers the named domain T, so the errors with the
+// "domain" JSON key set to its name are decoded as [GenericError] of the
+// domain T by [DecodeJSON], [Envelope.UnmarshalJSON] and when decoding the
+// wrapped errors (see [WithJSONCauses]). Registering another domain with the
+// same name replaces the previous registration.
+func RegisterDomain[T interface {
+	Domain
+	DomainNamer
+}]() {
+	domains.Store(domainName[T](), func() nodeDecoder { return &GenericError[T]{} })
+}
+
+// GetDomain returns the error domain name of the error if it implements the
+// [Domainer] interface. Otherwise, it returns an empty string. The error chain
+// (tree) is not traversed.
+func GetDomain(err error) string {
+	if e, ok := err.(Domainer); ok && !isNil(err) {
+		return e.ErrorDomain()
+	}
+	return ""
+}
+
+// DecodeJSON decodes the JSON representation of an error produced by
+// [MarshalJSON] or [json.Marshal]. The error is decoded as [GenericError] of
+// the domain registered with [RegisterDomain] for the "domain" key, or as
+// [Error] when the key is missing or the domain is not registered. See
+// [GenericError.UnmarshalJSON] for details.
+func DecodeJSON(data []byte) (DomainError, error) {
+	return decodeError[EDXrr](data)
+}
+
+// decodeNode decodes the error from the JSON object keys. The cause is the
+// already decoded wrapped error. The error is decoded as [GenericError] of the
+// domain registered for the "domain" key, or of the domain T when the key is
+// missing or the domain is not registered.
+func decodeNode[T Domain](m map[string]json.RawMessage, cause error) (DomainError, error) {
+	var e nodeDecoder = &GenericError[T]{}
+	var name string
+	_ = json.Unmarshal(m["domain"], &name)
+	if fn, ok := domains.Load(name); ok && name != "" {
+		e = fn.(func() nodeDecoder)()
+	}
+	if err := e.decode(m, cause); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// domainName returns the name of the domain T. It is the name returned by
+// the [DomainNamer] or the name of the marker type.
+func domainName[T Domain]() string {
+	if name := namedDomain[T](); name != "" {
+		return name
+	}
+	return reflect.TypeFor[T]().String()
+}
+
+// namedDomain returns the name of the domain T if the marker type implements
+// [DomainNamer]. Otherwise, it returns an empty string.
+func namedDomain[T Domain]() string {
+	var zero T
+	if dn, ok := any(zero).(DomainNamer); ok {
+		return dn.DomainName()
+	}
+	return ""
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+// edNamed is a named test error domain registered with [RegisterDomain].
+type edNamed struct{}
+
+func (edNamed) DomainName() string { return "named" }
+
+// edUnregistered is a named test error domain never registered with
+// [RegisterDomain].
+type edUnregistered struct{}
+
+func (edUnregistered) DomainName() string { return "unregistered" }
+
+func init() { RegisterDomain[edNamed]() }
+
+func Test_RegisterDomain(t *testing.T) {
+	// --- Given ---
+	type edLocal struct{ edNamed }
+
+	// --- When ---
+	RegisterDomain[edLocal]()
+
+	// --- Then ---
+	defer RegisterDomain[edNamed]()
+	have, err := DecodeJSON([]byte(`{"error": "msg", "domain": "named"}`))
+	assert.NoError(t, err)
+	assert.True(t, IsDomain[edLocal](have))
+}
+
+func Test_GetDomain_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		err  error
+		want string
+	}{
+		{"nil", nil, ""},
+		{"typed nil", (*GenericError[edNamed])(nil), ""},
+		{"standard error", errors.New("msg"), ""},
+		{"error", New("msg", "ECode"), "xrr.EDXrr"},
+		{"named domain", ErrorFunc[edNamed]()("msg", "ECode"), "named"},
+		{"fields", NewFieldError("f", New("msg", "ECode")), "xrr.EDXrr"},
+		{"wrapped is not inspected", Enclose(New("msg", "ECode")), ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := GetDomain(tc.err)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_DecodeJSON(t *testing.T) {
+	t.Run("without domain", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"error": "msg", "code": "ECode"}`)
+
+		// --- When ---
+		have, err := DecodeJSON(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, &Error{msg: "msg", code: "ECode"}, have)
+	})
+
+	t.Run("registered domain", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"error": "msg", "code": "ECode", "domain": "named"}`)
+
+		// --- When ---
+		have, err := DecodeJSON(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := &GenericError[edNamed]{msg: "msg", code: "ECode"}
+		assert.Equal(t, want, have)
+		assert.Equal(t, "named", have.ErrorDomain())
+	})
+
+	t.Run("not registered domain", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"error": "msg", "domain": "unregistered"}`)
+
+		// --- When ---
+		have, err := DecodeJSON(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, IsDomain[EDXrr](have))
+	})
+
+	t.Run("round trip with causes", func(t *testing.T) {
+		// --- Given ---
+		cause := ErrorFunc[edNamed]()("cause", "ECCause")
+		e := ErrorFunc[edUnregistered]()("msg", "ECode", WithCause(cause))
+		data := must.Value(MarshalJSON(e, WithJSONCauses()))
+
+		// --- When ---
+		have, err := DecodeJSON(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.True(t, IsDomain[EDXrr](have))
+		assert.True(t, IsDomain[edNamed](errors.Unwrap(have)))
+		assert.Equal(t, "msg: cause", have.Error())
+	})
+
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		// --- When ---
+		have, err := DecodeJSON([]byte(`{!!!}`))
+
+		// --- Then ---
+		var target *json.SyntaxError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - without the error key", func(t *testing.T) {
+		// --- When ---
+		have, err := DecodeJSON([]byte(`{"domain": "named"}`))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvJSONError, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_domainName(t *testing.T) {
+	t.Run("struct", func(t *testing.T) {
+		// --- When ---
+		have := domainName[EDXrr]()
+
+		// --- Then ---
+		assert.Equal(t, "xrr.EDXrr", have)
+	})
+
+	t.Run("builtin", func(t *testing.T) {
+		// --- When ---
+		have := domainName[string]()
+
+		// --- Then ---
+		assert.Equal(t, "string", have)
+	})
+
+	t.Run("named domain", func(t *testing.T) {
+		// --- When ---
+		have := domainName[edNamed]()
+
+		// --- Then ---
+		assert.Equal(t, "named", have)
+	})
+}
+
+func Test_namedDomain(t *testing.T) {
+	t.Run("not named", func(t *testing.T) {
+		// --- When ---
+		have := namedDomain[EDXrr]()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("named", func(t *testing.T) {
+		// --- When ---
+		have := namedDomain[edNamed]()
+
+		// --- Then ---
+		assert.Equal(t, "named", have)
+	})
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+// JSONOption represents an option for configuring JSON representation of
+// errors. See [MarshalJSON].
+type JSONOption func(*JSONOptions)
+
+// JSONOptions is a collection of options used when encoding errors to JSON.
+type JSONOptions struct {
+	stack     bool // Include recorded stack traces.
+	metaTypes bool // Include metadata type tags.
+	causes    bool // Encode the chain of causes instead of flattening it.
+	template  bool // Include message templates.
+
+	fieldStyle FieldStyle // Style of field errors.
+
+	lang    string          // Language of the localized messages.
+	catalog *MessageCatalog // Catalog of the localized messages.
+}
+
+// Set applies the provided options to the [JSONOptions] instance and returns
+// it.
+func (ops JSONOptions) Set(opts ...JSONOption) JSONOptions {
+	for _, opt := range opts {
+		opt(&ops)
+	}
+	return ops
+}
+
+// WithJSONStack is an option including the stack trace returned by
+// [GetStack] under the "stack" key. Stack traces reveal implementation
+// details, never use this option for errors sent to clients.
+func WithJSONStack() JSONOption {
+	return func(ops *JSONOptions) { ops.stack = true }
+}
+
+// WithJSONMetaTypes is an option including the "meta_types" object next to
+// the "meta" object. It maps metadata keys to their type tags (see the
+// MetaType* constants) which lets [GenericError.UnmarshalJSON] restore the
+// metadata values with their original types. The "meta" object itself is
+// the same as without the option, so third-party consumers are not affected.
+func WithJSONMetaTypes() JSONOption {
+	return func(ops *JSONOptions) { ops.metaTypes = true }
+}
+
+// WithJSONCauses is an option encoding the error chain (tree) as nested
+// objects instead of a single flattened one. Each object has the "error" key
+// with the complete message of the error, the "code" key with its code, the
+// "meta" key with its own metadata (see [Metadater]) and the "cause" key with
+// the wrapped error. Joined errors are encoded as arrays, and [Fielder]
+// errors as objects with the "fields" key:
+//
+//	{
+//	  "error": "op: a; b",
+//	  "code": "ECOp",
+//	  "cause": [
+//	    {"error": "a", "code": "ECA"},
+//	    {"error": "b", "code": "ECB", "meta": {"key": 1}}
+//	  ]
+//	}
+//
+// [GenericError.UnmarshalJSON] rebuilds the tree from this representation,
+// so [GetCodes], [GetMeta] and other functions inspecting the tree return the
+// same values for the decoded error as for the original one. The wrapped
+// errors are decoded as [GenericError] instances, so [errors.Is] matches
+// against the original sentinel errors are lost. Joined errors at the top
+// level are encoded as an object with the "cause" array, and decoded as
+// [GenericError] wrapping them.
+func WithJSONCauses() JSONOption {
+	return func(ops *JSONOptions) { ops.causes = true }
+}
+
+// WithJSONTemplate is an option including the message template (see
+// [GetTemplate]) under the "template" key next to the rendered message under
+// the "error" key. With [WithJSONCauses], each error in the tree includes
+// its own template.
+func WithJSONTemplate() JSONOption {
+	return func(ops *JSONOptions) { ops.template = true }
+}
+
+// WithJSONFieldStyle is an option setting the style of field errors in the
+// JSON representation of [GenericFields] and in the "fields" key of the
+// [Envelope]. See [FieldStyle] for the available styles, the default is
+// [FieldStyleDot]. [GenericFields.UnmarshalJSON] and
+// [Envelope.UnmarshalJSON] decode all the styles.
+//
+//	data, err := xrr.MarshalJSON(xrr.Enclose(err), xrr.WithJSONFieldStyle(xrr.FieldStylePointer))
+func WithJSONFieldStyle(style FieldStyle) JSONOption {
+	return func(ops *JSONOptions) { ops.fieldStyle = style }
+}
+
+// WithJSONLanguage is an option replacing the messages under the "error" keys
+// with the messages localized in the language (see
+// [MessageCatalog.Localize]). The default catalog is used unless another one
+// is set with [WithJSONMessageCatalog]. Messages of errors without templates
+// for their codes are not changed. Codes and other keys are never localized,
+// so clients may still rely on them:
+//
+//	data, err := xrr.MarshalJSON(xrr.Enclose(err), xrr.WithJSONLanguage("de"))
+func WithJSONLanguage(lang string) JSONOption {
+	return func(ops *JSONOptions) { ops.lang = lang }
+}
+
+// WithJSONMessageCatalog is an option setting the catalog used by
+// [WithJSONLanguage].
+func WithJSONMessageCatalog(cat *MessageCatalog) JSONOption {
+	return func(ops *JSONOptions) { ops.catalog = cat }
+}
+
+// jsonEncoder is the interface implemented by errors which JSON
+// representation can be configured with [JSONOptions].
+type jsonEncoder interface {
+	encodeJSON(ops JSONOptions) ([]byte, error)
+}
+
+// MarshalJSON returns the JSON representation of err configured with the
+// provided options. Without options, the result is the same as the one
+// returned by [json.Marshal] for errors defined in this package. Errors not
+// implementing [json.Marshaler] are represented the same way as errors
+// without a code in the [Envelope]. Returns "null" when err is nil.
+func MarshalJSON(err error, opts ...JSONOption) ([]byte, error) {
+	if err == nil {
+		return []byte("null"), nil
+	}
+	return marshalError(err, JSONOptions{}.Set(opts...))
+}
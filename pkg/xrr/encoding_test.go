@@ -0,0 +1,401 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_JSONOptions_Set(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		// --- Given ---
+		ops := JSONOptions{}
+
+		// --- When ---
+		have := ops.Set()
+
+		// --- Then ---
+		assert.Zero(t, have)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		ops := JSONOptions{}
+
+		// --- When ---
+		have := ops.Set(WithJSONStack())
+
+		// --- Then ---
+		assert.Equal(t, JSONOptions{stack: true}, have)
+		assert.Zero(t, ops)
+	})
+}
+
+func Test_WithJSONStack(t *testing.T) {
+	// --- Given ---
+	ops := &JSONOptions{}
+
+	// --- When ---
+	WithJSONStack()(ops)
+
+	// --- Then ---
+	assert.True(t, ops.stack)
+}
+
+func Test_WithJSONMetaTypes(t *testing.T) {
+	// --- Given ---
+	ops := &JSONOptions{}
+
+	// --- When ---
+	WithJSONMetaTypes()(ops)
+
+	// --- Then ---
+	assert.True(t, ops.metaTypes)
+}
+
+func Test_WithJSONCauses(t *testing.T) {
+	// --- Given ---
+	ops := &JSONOptions{}
+
+	// --- When ---
+	WithJSONCauses()(ops)
+
+	// --- Then ---
+	assert.True(t, ops.causes)
+}
+
+func Test_WithJSONTemplate(t *testing.T) {
+	// --- Given ---
+	ops := &JSONOptions{}
+
+	// --- When ---
+	WithJSONTemplate()(ops)
+
+	// --- Then ---
+	assert.True(t, ops.template)
+}
+
+func Test_WithJSONFieldStyle(t *testing.T) {
+	// --- Given ---
+	ops := &JSONOptions{}
+
+	// --- When ---
+	WithJSONFieldStyle(FieldStylePointer)(ops)
+
+	// --- Then ---
+	assert.Equal(t, FieldStylePointer, ops.fieldStyle)
+}
+
+func Test_WithJSONLanguage(t *testing.T) {
+	// --- Given ---
+	ops := &JSONOptions{}
+
+	// --- When ---
+	WithJSONLanguage("de")(ops)
+
+	// --- Then ---
+	assert.Equal(t, "de", ops.lang)
+}
+
+func Test_WithJSONMessageCatalog(t *testing.T) {
+	// --- Given ---
+	cat := NewMessageCatalog()
+	ops := &JSONOptions{}
+
+	// --- When ---
+	WithJSONMessageCatalog(cat)(ops)
+
+	// --- Then ---
+	assert.Same(t, cat, ops.catalog)
+}
+
+func Test_MarshalJSON(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have, err := MarshalJSON(nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "null", string(have))
+	})
+
+	t.Run("without options is the same as json.Marshal", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode", Meta().Int("A", 1).Option(), WithStack())
+
+		// --- When ---
+		have, err := MarshalJSON(e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want, _ := json.Marshal(e)
+		assert.JSON(t, string(want), string(have))
+	})
+
+	t.Run("std error", func(t *testing.T) {
+		// --- When ---
+		have, err := MarshalJSON(errors.New("msg"))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.JSON(t, `{"error": "msg", "code": "ECGeneric"}`, string(have))
+	})
+
+	t.Run("with stack", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode", WithStack())
+
+		// --- When ---
+		have, err := MarshalJSON(e, WithJSONStack())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		var m map[string]any
+		assert.NoError(t, json.Unmarshal(have, &m))
+		assert.Equal(t, "msg", m["error"])
+		stack, _ := m["stack"].([]any)
+		frame, _ := stack[0].(map[string]any)
+		assert.Equal(t, "github.com/ctx42/xrr/pkg/xrr.Test_MarshalJSON.func4", frame["func"])
+	})
+
+	t.Run("with stack option but no stack recorded", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode")
+
+		// --- When ---
+		have, err := MarshalJSON(e, WithJSONStack())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.JSON(t, `{"error": "msg", "code": "ECode"}`, string(have))
+	})
+
+	t.Run("with meta types", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC)
+		meta := Meta().Int("A", 1).Time("T", tim).Duration("D", time.Second)
+		e := New("msg", "ECode", meta.Option())
+
+		// --- When ---
+		have, err := MarshalJSON(e, WithJSONMetaTypes())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{
+			"error": "msg",
+			"code": "ECode",
+			"meta": {"A": 1, "D": 1000000000, "T": "2000-01-02T03:04:05Z"},
+			"meta_types": {"A": "int", "D": "duration", "T": "time"}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("with meta types without metadata", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode")
+
+		// --- When ---
+		have, err := MarshalJSON(e, WithJSONMetaTypes())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.JSON(t, `{"error": "msg", "code": "ECode"}`, string(have))
+	})
+
+	t.Run("meta types round trip", func(t *testing.T) {
+		// --- Given ---
+		tim := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+		meta := Meta().
+			Bool("bool", true).
+			Str("str", "abc").
+			Int("int", 1).
+			Int64("int64", 1<<60+1).
+			Float64("float64", 1.5).
+			Time("time", tim).
+			Duration("duration", time.Millisecond).
+			Uint64("uint64", 1<<64-1).
+			Strs("strs", "a", "b").
+			Ints("ints", 1, 2).
+			Group("group", Meta().Int("int", 1).Time("time", tim))
+		src := NewFieldError("f", New("msg", "ECode", meta.Option()))
+		data, err := MarshalJSON(Enclose(src), WithJSONMetaTypes())
+		assert.NoError(t, err)
+
+		// --- When ---
+		var env Envelope
+		err = json.Unmarshal(data, &env)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have := GetFieldError(env.Unwrap(), "f")
+		assert.Equal(t, GetMeta(src), GetMeta(have))
+		gotInt, _ := GetInt(have, "int")
+		assert.Equal(t, 1, gotInt)
+		gotTime, _ := GetTime(have, "time")
+		assert.Equal(t, tim, gotTime)
+		gotDur, _ := GetDuration(have, "duration")
+		assert.Equal(t, time.Millisecond, gotDur)
+	})
+
+	t.Run("with causes", func(t *testing.T) {
+		// --- Given ---
+		e := New(
+			"op",
+			"ECOp",
+			WithCause(errors.Join(
+				New("a", "ECA"),
+				fmt.Errorf("b: %w", New("c", "ECC", WithMeta(map[string]any{"k": 1}))),
+			)),
+			WithMeta(map[string]any{"k": 2}),
+		)
+
+		// --- When ---
+		have, err := MarshalJSON(e, WithJSONCauses())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{
+			"error": "op: a; b: c",
+			"code": "ECOp",
+			"meta": {"k": 2},
+			"cause": [
+				{"error": "a", "code": "ECA"},
+				{
+					"error": "b: c",
+					"code": "ECGeneric",
+					"cause": {"error": "c", "code": "ECC", "meta": {"k": 1}}
+				}
+			]
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("with causes and fields", func(t *testing.T) {
+		// --- Given ---
+		e := Wrap(NewFieldErrors(map[string]error{
+			"f0": New("m0", "EC0"),
+			"f1": nil,
+		}))
+
+		// --- When ---
+		have, err := MarshalJSON(e, WithJSONCauses())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{
+			"error": "f0: m0",
+			"code": "ECGeneric",
+			"cause": {
+				"error": "f0: m0",
+				"fields": {"f0": {"error": "m0", "code": "EC0"}}
+			}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("causes round trip", func(t *testing.T) {
+		tt := []struct {
+			testN string
+
+			err error
+		}{
+			{"case 1", TstTreeCase1()},
+			{"case 2", TstTreeCase2()},
+			{"case 3", TstTreeCase3()},
+			{"case 5", Wrap(TstTreeCase5(), WithCode("ECFields"))},
+			{"meta tree", TstMetaTree()},
+			{"meta tree with duplicates", TstTreeMeta()},
+			{
+				"fielder coder",
+				Wrap(TFielderCoder{
+					code:   "ECFielder",
+					fields: map[string]error{"f": New("m", "EC")},
+				}),
+			},
+			{
+				"message not derivable from cause",
+				&GenericError[EDXrr]{
+					msg:      "custom",
+					err:      New("cause", "ECCause"),
+					verbatim: true,
+				},
+			},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.testN, func(t *testing.T) {
+				// --- Given ---
+				opts := []JSONOption{WithJSONCauses(), WithJSONMetaTypes()}
+				data := must.Value(MarshalJSON(tc.err, opts...))
+
+				// --- When ---
+				var have *Error
+				err := json.Unmarshal(data, &have)
+
+				// --- Then ---
+				assert.NoError(t, err)
+				assert.Equal(t, tc.err.Error(), have.Error())
+				assert.Equal(t, GetCodes(tc.err), GetCodes(have))
+				assert.Equal(t, GetMeta(tc.err), GetMeta(have))
+			})
+		}
+	})
+
+	t.Run("causes in envelope round trip", func(t *testing.T) {
+		// --- Given ---
+		cause := NewFieldError("f", New("op", "ECOp", WithCause(New("m", "EC"))))
+		data := must.Value(MarshalJSON(Enclose(cause), WithJSONCauses()))
+
+		// --- When ---
+		var env Envelope
+		err := json.Unmarshal(data, &env)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		have := GetFieldError(env.Unwrap(), "f")
+		assert.Equal(t, "op: m", have.Error())
+		assert.Equal(t, []string{"ECOp", "EC"}, GetCodes(have))
+	})
+
+	t.Run("stack option is passed to envelope errors", func(t *testing.T) {
+		// --- Given ---
+		cause := New("cause", "ECCause", WithStack())
+		e := Enclose(cause, New("lead", "ECLead"))
+
+		// --- When ---
+		have, err := MarshalJSON(e, WithJSONStack())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		var m struct {
+			Stack  []any            `json:"stack"`
+			Errors []map[string]any `json:"errors"`
+		}
+		assert.NoError(t, json.Unmarshal(have, &m))
+		assert.Nil(t, m.Stack)
+		assert.Len(t, 1, m.Errors)
+		assert.HasKey(t, "stack", m.Errors[0])
+	})
+
+	t.Run("stack option is passed to field errors", func(t *testing.T) {
+		// --- Given ---
+		e := NewFieldError("f0", New("msg", "ECode", WithStack()))
+
+		// --- When ---
+		have, err := MarshalJSON(e, WithJSONStack())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		var m map[string]map[string]any
+		assert.NoError(t, json.Unmarshal(have, &m))
+		assert.HasKey(t, "stack", m["f0"])
+	})
+}
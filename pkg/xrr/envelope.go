@@ -8,6 +8,14 @@ import (
 	"errors"
 )
 
+// Compile time checks.
+var (
+	_ error            = Envelope{}
+	_ Coder            = Envelope{}
+	_ json.Marshaler   = Envelope{}
+	_ json.Unmarshaler = (*Envelope)(nil)
+)
+
 // Envelope provides facilities to create JSON envelope for errors.
 //
 // Envelope has two fields `cause` and `lead`. The `cause` is the error we
@@ -120,11 +128,16 @@ func (e Envelope) Is(target error) bool {
 }
 
 func (e Envelope) MarshalJSON() ([]byte, error) {
+	return e.encodeJSON(JSONOptions{})
+}
+
+// encodeJSON returns JSON representation of the envelope configured with ops.
+func (e Envelope) encodeJSON(ops JSONOptions) ([]byte, error) {
 	if ef, ok := e.cause.(Fielder); ok {
 		if e.lead == nil {
 			e.lead = ErrFields
 		}
-		return encloseFieldsError(e.lead, ef)
+		return encloseFieldsError(ops, e.lead, ef)
 	}
 
 	if IsJoined(e.cause) {
@@ -133,34 +146,121 @@ func (e Envelope) MarshalJSON() ([]byte, error) {
 			e.lead = ers[0]
 			ers = ers[1:]
 		}
-		return encloseMultiError(e.lead, ers...)
+		return encloseMultiError(ops, e.lead, ers...)
 	}
 
 	if e.lead != nil {
-		return encloseMultiError(e.lead, e.cause)
+		return encloseMultiError(ops, e.lead, e.cause)
 	}
-	return encloseMultiError(e.cause)
+	return encloseMultiError(ops, e.cause)
 }
 
-// encloseFieldsError returns [Fields] error enclosed in an error envelope with
-// given leading error.
-func encloseFieldsError(lead error, ef Fielder) ([]byte, error) {
-	ret := errorAsMap(lead)
-	ret["fields"] = &GenericFields[EDXrr]{fields: ef.ErrorFields()}
-	if meta := GetMeta(lead); len(meta) > 0 {
-		ret["meta"] = meta
+// UnmarshalJSON unmarshals JSON representation of the [Envelope] produced by
+// [Envelope.MarshalJSON]. The top-level error is decoded as [Error], or as
+// [GenericError] of the domain registered with [RegisterDomain] for the
+// "domain" key, and:
+//   - when the "fields" key is present, it becomes the lead error and the
+//     cause is the [FieldErrors] decoded from the "fields" value in any of
+//     the [FieldStyle] styles,
+//   - when the "errors" key is present, it becomes the lead error and the
+//     cause is the joined [Error] instances decoded from the "errors" array
+//     (a single error is not joined); the entries which are not error
+//     objects are decoded as [FieldErrors],
+//   - otherwise, it becomes the cause and the lead error is nil.
+//
+// Decoded this way, marshaling the envelope again produces the same JSON,
+// and [GetCode], [IsCode], [GetCodes], [GetFieldError] (on the cause) and
+// [Envelope.Lead] report the same values as for the original envelope, with
+// the exception of joined causes without the lead error, where the first
+// joined error becomes the lead.
+//
+// See [GenericError.UnmarshalJSON] for the notes about the decoded metadata.
+func (e *Envelope) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Errors []json.RawMessage `json:"errors"`
+		Fields json.RawMessage   `json:"fields"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	top, err := decodeError[EDXrr](data)
+	if err != nil {
+		return err
+	}
+
+	if len(raw.Fields) > 0 && string(raw.Fields) != "null" {
+		fields := &FieldErrors{}
+		if err = json.Unmarshal(raw.Fields, fields); err != nil {
+			return err
+		}
+		e.lead, e.cause = top, fields
+		return nil
+	}
+
+	if len(raw.Errors) > 0 {
+		ers := make([]error, len(raw.Errors))
+		for i, entry := range raw.Errors {
+			if isFieldsEntry(entry) {
+				fields := &FieldErrors{}
+				if err = json.Unmarshal(entry, fields); err != nil {
+					return err
+				}
+				ers[i] = fields
+				continue
+			}
+			if ers[i], err = decodeError[EDXrr](entry); err != nil {
+				return err
+			}
+		}
+		e.lead, e.cause = top, Join(ers...)
+		return nil
+	}
+
+	e.lead, e.cause = nil, top
+	return nil
+}
+
+// isFieldsEntry returns true when the "errors" array entry is the JSON
+// representation of field errors rather than an error object. Error objects
+// have the "error" or "code" key with a string value, field errors are arrays
+// or objects with object and array values.
+func isFieldsEntry(data json.RawMessage) bool {
+	if len(data) > 0 && data[0] == '[' {
+		return true
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return false
+	}
+	for _, key := range []string{"error", "code"} {
+		if val := raw[key]; len(val) > 0 && val[0] == '"' {
+			return false
+		}
+	}
+	return true
+}
+
+// encloseFieldsError returns [Fielder] error enclosed in an error envelope
+// with given leading error.
+func encloseFieldsError(ops JSONOptions, lead error, ef Fielder) ([]byte, error) {
+	ret := errorAsMap(lead, ops)
+	fields := &GenericFields[EDXrr]{fields: ef.ErrorFields(), order: orderOf(ef)}
+	data, err := fields.encodeJSON(ops)
+	if err != nil {
+		return nil, err
 	}
+	ret["fields"] = json.RawMessage(data)
 	return json.Marshal(ret)
 }
 
 // encloseMultiError returns multiple errors enclosed in an error envelope with
 // given leading error.
-func encloseMultiError(lead error, ers ...error) ([]byte, error) {
-	ret := errorAsMap(lead)
+func encloseMultiError(ops JSONOptions, lead error, ers ...error) ([]byte, error) {
+	ret := errorAsMap(lead, ops)
 	if len(ers) > 0 {
 		es := make([]json.RawMessage, len(ers))
 		for i, e := range ers {
-			entry, err := marshalError(e)
+			entry, err := marshalError(e, ops)
 			if err != nil {
 				return nil, err
 			}
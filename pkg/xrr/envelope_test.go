@@ -353,6 +353,248 @@ func Test_Envelope_MarshalJSON(t *testing.T) {
 	})
 }
 
+func Test_Envelope_UnmarshalJSON(t *testing.T) {
+	t.Run("single error", func(t *testing.T) {
+		// --- Given ---
+		src := Enclose(New("cause", "ECCause", Meta().Str("A", "a").Option()))
+		data := must.Value(json.Marshal(src))
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, have.Lead())
+		assert.ErrorEqual(t, "cause", have)
+		assert.Equal(t, "ECCause", GetCode(have))
+		assert.Equal(t, GetCodes(src), GetCodes(have))
+		a, _ := GetStr(have, "A")
+		assert.Equal(t, "a", a)
+		assert.JSON(t, string(data), string(must.Value(json.Marshal(have))))
+	})
+
+	t.Run("cause and lead", func(t *testing.T) {
+		// --- Given ---
+		src := Enclose(New("cause", "ECCause"), New("lead", "ECLead"))
+		data := must.Value(json.Marshal(src))
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "lead", have.Lead())
+		assert.Equal(t, "ECLead", GetCode(have.Lead()))
+		assert.ErrorEqual(t, "cause", have)
+		assert.Equal(t, "ECCause", GetCode(have))
+		assert.True(t, IsCode(have, "ECCause"))
+		assert.Equal(t, GetCodes(src), GetCodes(have))
+		assert.JSON(t, string(data), string(must.Value(json.Marshal(have))))
+	})
+
+	t.Run("joined cause and lead", func(t *testing.T) {
+		// --- Given ---
+		cause := errors.Join(New("cause 0", "EC0"), New("cause 1", "EC1"))
+		src := Enclose(cause, New("lead", "ECLead"))
+		data := must.Value(json.Marshal(src))
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "lead", have.Lead())
+		assert.True(t, IsJoined(have.Unwrap()))
+		assert.Equal(t, GetCode(src), GetCode(have))
+		assert.Equal(t, GetCodes(src), GetCodes(have))
+		assert.JSON(t, string(data), string(must.Value(json.Marshal(have))))
+	})
+
+	t.Run("joined cause without lead", func(t *testing.T) {
+		// --- Given ---
+		cause := errors.Join(New("cause 0", "EC0"), New("cause 1", "EC1"))
+		src := Enclose(cause)
+		data := must.Value(json.Marshal(src))
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "cause 0", have.Lead())
+		assert.ErrorEqual(t, "cause 1", have.Unwrap())
+		assert.JSON(t, string(data), string(must.Value(json.Marshal(have))))
+	})
+
+	t.Run("joined cause with fields", func(t *testing.T) {
+		// --- Given ---
+		fields := NewFieldErrors(map[string]error{"f": New("msg f", "ECF")})
+		cause := Join(New("a", "ECA"), fields)
+		src := Enclose(cause, New("lead", "ECLead"))
+		data := must.Value(json.Marshal(src))
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "lead", have.Lead())
+		assert.Equal(t, GetCodes(src), GetCodes(have))
+		ers := Split(have.Unwrap())
+		assert.Len(t, 2, ers)
+		assert.ErrorEqual(t, "a", ers[0])
+		fe := GetFieldError(ers[1], "f")
+		assert.ErrorEqual(t, "msg f", fe)
+		assert.Equal(t, "ECF", GetCode(fe))
+		assert.JSON(t, string(data), string(must.Value(json.Marshal(have))))
+	})
+
+	t.Run("joined cause with fields in list style", func(t *testing.T) {
+		// --- Given ---
+		fields := NewFieldErrors(map[string]error{"f": New("msg f", "ECF")})
+		cause := Join(New("a", "ECA"), fields)
+		src := Enclose(cause, New("lead", "ECLead"))
+		style := WithJSONFieldStyle(FieldStyleList)
+		data := must.Value(MarshalJSON(src, style))
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		ers := Split(have.Unwrap())
+		assert.Len(t, 2, ers)
+		assert.ErrorEqual(t, "msg f", GetFieldError(ers[1], "f"))
+		assert.JSON(t, string(data), string(must.Value(MarshalJSON(have, style))))
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		// --- Given ---
+		cause := NewFieldErrors(map[string]error{
+			"a": New("msg a", "ECA"),
+			"b": New("msg b", "ECB"),
+		})
+		src := Enclose(cause, New("lead", "ECLead", Meta().Int("A", 1).Option()))
+		data := must.Value(json.Marshal(src))
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "lead", have.Lead())
+		assert.Equal(t, GetCode(src), GetCode(have))
+		assert.Equal(t, GetCodes(src), GetCodes(have))
+		assert.True(t, IsCode(have, "ECB"))
+		fe := GetFieldError(have.Unwrap(), "b")
+		assert.ErrorEqual(t, "msg b", fe)
+		assert.Equal(t, "ECB", GetCode(fe))
+		assert.JSON(t, string(data), string(must.Value(json.Marshal(have))))
+	})
+
+	t.Run("fields without lead", func(t *testing.T) {
+		// --- Given ---
+		cause := NewFieldErrors(map[string]error{"a": New("msg a", "ECA")})
+		data := must.Value(json.Marshal(Enclose(cause)))
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "fields error", have.Lead())
+		assert.Equal(t, ECFields, GetCode(have.Lead()))
+		assert.JSON(t, string(data), string(must.Value(json.Marshal(have))))
+	})
+
+	t.Run("fields in all styles", func(t *testing.T) {
+		styles := []FieldStyle{
+			FieldStyleDot,
+			FieldStyleBracket,
+			FieldStylePointer,
+			FieldStyleNested,
+			FieldStyleList,
+		}
+		for _, style := range styles {
+			// --- Given ---
+			fc := NewFieldCollector[EDXrr]()
+			fc.Field("items").Index(3).Add("price", New("negative", "ECNeg"))
+			fc.Add("name", New("required", "ECRequired"))
+			src := Enclose(fc.Err(), New("lead", "ECLead"))
+			data := must.Value(MarshalJSON(src, WithJSONFieldStyle(style)))
+
+			// --- When ---
+			var have Envelope
+			err := json.Unmarshal(data, &have)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.ErrorEqual(t, "lead", have.Lead())
+			fe := GetFieldError(have.Unwrap(), "items[3].price")
+			assert.ErrorEqual(t, "negative", fe)
+			assert.Equal(t, "ECNeg", GetCode(fe))
+			assert.Equal(t, GetCodes(src), GetCodes(have))
+			again := must.Value(MarshalJSON(have, WithJSONFieldStyle(style)))
+			assert.JSON(t, string(data), string(again))
+		}
+	})
+
+	t.Run("error - without the error key", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"code": "ECode"}`)
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvJSONError, err)
+	})
+
+	t.Run("error - invalid entry in errors", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"error": "lead", "errors": [{"code": "ECode"}]}`)
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvJSONError, err)
+	})
+
+	t.Run("error - invalid fields", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"error": "lead", "fields": [1, 2]}`)
+
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+	})
+
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		// --- When ---
+		var have Envelope
+		err := json.Unmarshal([]byte(`{!!!}`), &have)
+
+		// --- Then ---
+		var target *json.SyntaxError
+		assert.ErrorAs(t, &target, err)
+	})
+}
+
 func Test_encloseFieldsError(t *testing.T) {
 	t.Run("lead without metadata", func(t *testing.T) {
 		// --- Given ---
@@ -365,7 +607,7 @@ func Test_encloseFieldsError(t *testing.T) {
 		lead := New("lead", "ECL")
 
 		// --- When ---
-		have, err := encloseFieldsError(lead, cause)
+		have, err := encloseFieldsError(JSONOptions{}, lead, cause)
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -392,7 +634,7 @@ func Test_encloseFieldsError(t *testing.T) {
 		lead := New("lead", "ECL", Meta().Int("B", 1).Option())
 
 		// --- When ---
-		have, err := encloseFieldsError(lead, cause)
+		have, err := encloseFieldsError(JSONOptions{}, lead, cause)
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -418,7 +660,7 @@ func Test_encloseMultiError(t *testing.T) {
 		lead := New("lead", "ECL")
 
 		// --- When ---
-		have, err := encloseMultiError(lead, e0, e1)
+		have, err := encloseMultiError(JSONOptions{}, lead, e0, e1)
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -440,7 +682,7 @@ func Test_encloseMultiError(t *testing.T) {
 		lead := New("lead", "ECL", Meta().Int("B", 1).Option())
 
 		// --- When ---
-		have, err := encloseMultiError(lead, cause)
+		have, err := encloseMultiError(JSONOptions{}, lead, cause)
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -461,7 +703,7 @@ func Test_encloseMultiError(t *testing.T) {
 		lead := New("lead", "ECL", Meta().Int("A", 0).Option())
 
 		// --- When ---
-		have, err := encloseMultiError(lead)
+		have, err := encloseMultiError(JSONOptions{}, lead)
 
 		// --- Then ---
 		assert.NoError(t, err)
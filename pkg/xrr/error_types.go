@@ -6,9 +6,8 @@ package xrr
 // EDXrr is the marker type for the package's error domain.
 type EDXrr struct{}
 
-// Error constructor functions for the xrr package [edXrr] domain.
+// Error constructor function for the xrr package [edXrr] domain.
 var (
-	newError       = ErrorFunc[EDXrr]()
 	newFieldsError = FieldsFunc[EDXrr]()
 )
 
@@ -27,7 +26,19 @@ type Error = GenericError[EDXrr]
 // For wrapping without a new message, prefer [Wrap] which makes the intent
 // clearer.
 func New(msg, code string, opts ...Option) error {
-	return newError(msg, code, opts...)
+	return newGenericError[EDXrr](1, msg, code, opts...)
+}
+
+// NewTemplate creates a new [Error] with the message rendered from the
+// template and the error code. See [TemplateFunc] for details.
+func NewTemplate(tpl, code string, opts ...Option) error {
+	return newTemplateError[EDXrr](1, tpl, code, opts...)
+}
+
+// Errorf creates a new [Error] with the error code and the message formatted
+// according to the format specifier. See [ErrorfFunc] for details.
+func Errorf(code, format string, args ...any) error {
+	return newErrorf[EDXrr](1, code, format, args...)
 }
 
 // FieldErrors represents a field error in the xrr error domain.
@@ -55,5 +66,5 @@ func NewFieldErrors(fields map[string]error) *FieldErrors {
 //
 // To annotate with a new message as well, use [New] with [WithCause].
 func Wrap(err error, opts ...Option) error {
-	return WrapUsing[EDXrr](err, opts...)
+	return wrapUsing[EDXrr](1, err, opts...)
 }
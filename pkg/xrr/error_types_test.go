@@ -50,6 +50,63 @@ func Test_New(t *testing.T) {
 	})
 }
 
+func Test_NewTemplate(t *testing.T) {
+	t.Run("without options", func(t *testing.T) {
+		// --- When ---
+		err := NewTemplate("user {id}", "ECode")
+
+		// --- Then ---
+		e, _ := assert.SameType(t, &GenericError[EDXrr]{}, err)
+		assert.Equal(t, "user %!id(MISSING)", e.Error())
+		assert.Equal(t, "user {id}", e.tpl)
+		assert.Equal(t, "ECode", e.code)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		opt := Meta().Str("id", "u-1").Option()
+
+		// --- When ---
+		err := NewTemplate("user {id}", "ECode", opt, WithStack())
+
+		// --- Then ---
+		e, _ := assert.SameType(t, &GenericError[EDXrr]{}, err)
+		assert.Equal(t, "user u-1", e.Error())
+		assert.Equal(t, "user {id}", e.tpl)
+		assert.Equal(t, map[string]any{"id": "u-1"}, e.meta)
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_NewTemplate.func2"
+		assert.Equal(t, wFn, e.stack.Frames()[0].Function)
+	})
+}
+
+func Test_Errorf(t *testing.T) {
+	t.Run("without wrapped errors", func(t *testing.T) {
+		// --- When ---
+		err := Errorf("ECode", "user %s", Arg("id", "u-1"))
+
+		// --- Then ---
+		e, _ := assert.SameType(t, &GenericError[EDXrr]{}, err)
+		assert.Equal(t, "user u-1", e.Error())
+		assert.Equal(t, "ECode", e.code)
+		assert.Equal(t, map[string]any{"id": "u-1"}, e.meta)
+	})
+
+	t.Run("with wrapped error and options", func(t *testing.T) {
+		// --- Given ---
+		cause := errors.New("cause")
+
+		// --- When ---
+		err := Errorf("ECode", "op: %w", cause, WithStack())
+
+		// --- Then ---
+		e, _ := assert.SameType(t, &GenericError[EDXrr]{}, err)
+		assert.Equal(t, "op: cause", e.Error())
+		assert.Same(t, cause, e.err)
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_Errorf.func2"
+		assert.Equal(t, wFn, e.stack.Frames()[0].Function)
+	})
+}
+
 func Test_NewFieldError(t *testing.T) {
 	t.Run("not nil error", func(t *testing.T) {
 		// --- Given ---
@@ -139,6 +139,21 @@ func ExampleNew_withCauseAndMsg() {
 	// dial failed: connection refused
 }
 
+func ExampleErrorf() {
+	cause := xrr.New("connection refused", "EC_CONN")
+	err := xrr.Errorf("", "dial %s: %w", xrr.Arg("host", "db:5432"), cause)
+
+	fmt.Println(errors.Is(err, cause))
+	fmt.Println(xrr.GetCode(err))
+	fmt.Println(xrr.GetMeta(err))
+	fmt.Println(err.Error())
+	// Output:
+	// true
+	// EC_CONN
+	// map[host:db:5432]
+	// dial db:5432: connection refused
+}
+
 func ExampleGenericFields() {
 	fields := map[string]error{
 		"username": errors.New("username not found"),
@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// DefaultFieldTags lists the struct tags used by [NewFieldNamer] when no tags
+// are provided, in the order they are checked.
+var DefaultFieldTags = []string{"xrr", "json", "form"}
+
+// FieldNamer resolves the Go field names of a struct type to the names from
+// the struct tags, so field errors are keyed by the names known to the
+// clients, for example, "email_address" instead of "EmailAddress":
+//
+//	type Address struct {
+//	    ZipCode string `json:"zip"`
+//	}
+//
+//	type User struct {
+//	    EmailAddress string   `json:"email_address"`
+//	    Address      *Address `json:"address"`
+//	    Items        []Item   `json:"items"`
+//	}
+//
+//	fn := xrr.NewFieldNamer(User{})
+//	fn.Name("EmailAddress")    // email_address
+//	fn.Name("Address.ZipCode") // address.zip
+//	fn.Name("Items[3].Price")  // items[3].price
+//
+// The tags are checked in order, and the first one with a name, other than
+// "-", is used. Fields without such tags keep their Go names. Fields of the
+// embedded structs without a tag name are promoted the same way as in the
+// [encoding/json] package, including the rules for the promoted fields
+// sharing the same Go name. Names not found in the struct type, or
+// ambiguous, are kept as they are. It is safe for concurrent use.
+type FieldNamer struct {
+	typ   reflect.Type // Struct type.
+	tags  []string     // Struct tags in the order they are checked.
+	cache sync.Map     // Fields by struct type.
+}
+
+// structField represents a struct field resolved by [FieldNamer].
+type structField struct {
+	name   string       // Field name from the struct tags.
+	typ    reflect.Type // Field type.
+	inline bool         // Embedded struct which fields are promoted.
+}
+
+// NewFieldNamer returns a new instance of [FieldNamer] for the type of v,
+// which may be a struct, a pointer to a struct or the [reflect.Type] of
+// them. When no tags are provided, the [DefaultFieldTags] are used.
+func NewFieldNamer(v any, tags ...string) *FieldNamer {
+	typ, ok := v.(reflect.Type)
+	if !ok {
+		typ = reflect.TypeOf(v)
+	}
+	if len(tags) == 0 {
+		tags = DefaultFieldTags
+	}
+	return &FieldNamer{typ: typ, tags: tags}
+}
+
+// Name returns the field name or path (see [ParseFieldPath]) with the Go
+// field names resolved to the names from the struct tags.
+func (fn *FieldNamer) Name(field string) string {
+	return fn.Path(ParseFieldPath(field)).String()
+}
+
+// Path returns the path with the Go field names resolved to the names from
+// the struct tags.
+func (fn *FieldNamer) Path(pth FieldPath) FieldPath {
+	segs, _ := fn.resolve(fn.typ, pth.segs)
+	return FieldPath{segs: segs}
+}
+
+// resolve returns the path segments with the Go field names of the type
+// resolved to the names from the struct tags and the type the path leads to.
+// The returned type is nil when it cannot be determined.
+func (fn *FieldNamer) resolve(typ reflect.Type, segs []string) ([]string, reflect.Type) {
+	ret := make([]string, 0, len(segs))
+	for i, seg := range segs {
+		typ = deref(typ)
+		if typ == nil {
+			return append(ret, segs[i:]...), nil
+		}
+		switch {
+		case typ.Kind() == reflect.Map:
+			ret, typ = append(ret, seg), typ.Elem()
+
+		case isIndexSeg(seg):
+			switch typ.Kind() {
+			case reflect.Slice, reflect.Array:
+				ret, typ = append(ret, seg), typ.Elem()
+			default:
+				ret, typ = append(ret, seg), nil
+			}
+
+		case typ.Kind() == reflect.Struct:
+			sf, ok := fn.fields(typ)[seg]
+			if !ok {
+				ret, typ = append(ret, seg), nil
+				continue
+			}
+			if !sf.inline {
+				ret = append(ret, sf.name)
+			}
+			typ = sf.typ
+
+		default:
+			ret, typ = append(ret, seg), nil
+		}
+	}
+	return ret, typ
+}
+
+// fields returns the fields of the struct type by Go name, including the
+// fields promoted from the embedded structs.
+func (fn *FieldNamer) fields(typ reflect.Type) map[string]structField {
+	if fs, ok := fn.cache.Load(typ); ok {
+		return fs.(map[string]structField)
+	}
+	fs := fn.collect(typ)
+	fn.cache.Store(typ, fs)
+	return fs
+}
+
+// collect returns the fields of the struct type by Go name, including the
+// fields promoted from the embedded structs. The embedded structs are
+// visited breadth-first, and each struct type only once, at the shallowest
+// depth, so the structs embedding themselves are supported. See [dominant]
+// for the fields sharing the same Go name.
+func (fn *FieldNamer) collect(typ reflect.Type) map[string]structField {
+	cands := make(map[string][]fieldCandidate, typ.NumField())
+	visited := map[reflect.Type]bool{}
+	current := []reflect.Type{typ}
+	for depth := 0; len(current) > 0; depth++ {
+		var next []reflect.Type
+		for _, st := range current {
+			if visited[st] {
+				continue
+			}
+			visited[st] = true
+			for i := 0; i < st.NumField(); i++ {
+				f := st.Field(i)
+				if !f.IsExported() && !f.Anonymous {
+					continue
+				}
+				cand := fieldCandidate{depth: depth}
+				name := fn.tagName(f.Tag)
+				if f.Anonymous && name == "" {
+					if et := deref(f.Type); et != nil && et.Kind() == reflect.Struct {
+						cand.structField = structField{typ: f.Type, inline: true}
+						cands[f.Name] = append(cands[f.Name], cand)
+						next = append(next, et)
+						continue
+					}
+				}
+				cand.tagged = name != ""
+				if name == "" {
+					name = f.Name
+				}
+				cand.structField = structField{name: name, typ: f.Type}
+				cands[f.Name] = append(cands[f.Name], cand)
+			}
+		}
+		current = next
+	}
+
+	fs := make(map[string]structField, len(cands))
+	for key, cs := range cands {
+		if sf, ok := dominant(cs); ok {
+			fs[key] = sf
+		}
+	}
+	return fs
+}
+
+// fieldCandidate represents a struct field found by [FieldNamer.collect] at
+// the depth of the embedded structs.
+type fieldCandidate struct {
+	structField
+	depth  int  // Depth of the embedded structs, zero for direct fields.
+	tagged bool // The name is from the struct tags.
+}
+
+// dominant returns the field chosen from the fields sharing the same Go name
+// using the same rules as the [encoding/json] package: the field at the
+// shallowest depth wins, from many fields at that depth, the only one named
+// with the struct tags wins. Returns false when the field is still
+// ambiguous, in which case the Go name is not resolved. The candidates must
+// be sorted by depth.
+func dominant(cands []fieldCandidate) (structField, bool) {
+	depth := cands[0].depth
+	var ret []fieldCandidate
+	for _, cand := range cands {
+		if cand.depth == depth {
+			ret = append(ret, cand)
+		}
+	}
+	if len(ret) == 1 {
+		return ret[0].structField, true
+	}
+	var tagged []fieldCandidate
+	for _, cand := range ret {
+		if cand.tagged {
+			tagged = append(tagged, cand)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0].structField, true
+	}
+	return structField{}, false
+}
+
+// tagName returns the field name from the first of the struct tags with a
+// name other than "-". Returns an empty string if none of the tags has one.
+func (fn *FieldNamer) tagName(tag reflect.StructTag) string {
+	for _, key := range fn.tags {
+		name, _, _ := strings.Cut(tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return ""
+}
+
+// deref returns the type with the pointers dereferenced.
+func deref(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// NewStructFieldCollector returns a new instance of [FieldCollector] for the
+// struct value v, which collects the errors added for the Go field names
+// (see [FieldCollector.Add]) under the names from the struct tags (see
+// [NewFieldNamer]):
+//
+//	fc := xrr.NewStructFieldCollector[edUser](u, "json")
+//	fc.Field("Address").Add("ZipCode", ErrInvalid)
+//	fc.Err() // address.zip: invalid
+func NewStructFieldCollector[T Domain](v any, tags ...string) *FieldCollector[T] {
+	return &FieldCollector[T]{
+		root:  &GenericFields[T]{},
+		namer: NewFieldNamer(v, tags...),
+	}
+}
+
+// RenameFields returns the field errors with the keys of err re-keyed from
+// the Go field names of the struct type of v to the names from the struct
+// tags (see [NewFieldNamer]). The keys may be paths, for example,
+// "Address.ZipCode", and nested field errors are re-keyed using the types of
+// the fields they are set for. The nested field errors set for the embedded
+// structs, which fields are promoted, are merged into the parent, the same
+// way as the promoted fields are named. The errors re-keyed to the same name
+// are all kept (see [GenericFields.AddField]). The field order is preserved
+// (see [NewOrderedFields]). Returns err when it does not implement
+// [Fielder].
+func RenameFields[T Domain](err error, v any, tags ...string) error {
+	fe, ok := err.(Fielder) // nolint: errorlint
+	if !ok {
+		return err
+	}
+	fn := NewFieldNamer(v, tags...)
+	return renameFields[T](fn, fn.typ, fe)
+}
+
+// renameFields returns a new instance of [GenericFields] with the keys of
+// the [Fielder] resolved for the type.
+func renameFields[T Domain](fn *FieldNamer, typ reflect.Type, fe Fielder) *GenericFields[T] {
+	fields := fe.ErrorFields()
+	order := orderOf(fe)
+	ret := &GenericFields[T]{fields: make(map[string]error, len(fields))}
+	if order != nil {
+		ret.order = make([]string, 0, len(fields))
+	}
+	add := func(name string, err error) {
+		if err != nil {
+			ret.AddField(name, err)
+			return
+		}
+		if _, ok := ret.fields[name]; !ok {
+			ret.Set(name, nil)
+		}
+	}
+	for _, key := range fieldNames(fields, order) {
+		err := fields[key]
+		segs, ft := fn.resolve(typ, ParseFieldPath(key).segs)
+		sub, isSub := err.(Fielder) // nolint: errorlint
+		if isSub {
+			err = renameFields[T](fn, ft, sub)
+		}
+		if len(segs) > 0 || key == "" {
+			add(FieldPath{segs: segs}.String(), err)
+			continue
+		}
+		// The key names an embedded struct which fields are promoted.
+		if !isSub {
+			add("", err)
+			continue
+		}
+		nested := err.(*GenericFields[T]) // nolint: errorlint
+		for _, name := range fieldNames(nested.fields, nested.order) {
+			add(name, nested.fields[name])
+		}
+	}
+	return ret
+}
@@ -0,0 +1,420 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+type TNameAddress struct {
+	ZipCode string `json:"zip" form:"zip_code"`
+	City    string
+}
+
+type TNameBase struct {
+	ID      string `json:"id"`
+	Created string `json:"created_at"`
+}
+
+type TNameMeta struct {
+	Source string `json:"source"`
+}
+
+type TNameItem struct {
+	Price int `json:"price"`
+}
+
+type TNameUser struct {
+	TNameBase
+	*TNameMeta `json:"meta"`
+
+	EmailAddress string                  `json:"email_address,omitempty"`
+	Nickname     string                  `xrr:"nick" json:"nickname"`
+	Password     string                  `json:"-"`
+	Address      *TNameAddress           `json:"address"`
+	Items        []TNameItem             `json:"items"`
+	Grid         [2][]TNameItem          `json:"grid"`
+	Labels       map[string]TNameAddress `json:"labels"`
+	ID           int                     `json:"user_id"`
+	private      string                  // nolint: unused
+}
+
+type TNameCodeA struct {
+	Code string `json:"code_a"`
+	Name string
+}
+
+type TNameCodeB struct {
+	Code string `json:"code_b"`
+	Name string `json:"name"`
+}
+
+type TNameDeep struct {
+	TNameCodeB
+}
+
+type TNameSameDepth struct {
+	TNameCodeA
+	TNameCodeB
+}
+
+type TNameDifferentDepth struct {
+	TNameCodeA
+	TNameDeep
+}
+
+type TNameSelf struct {
+	*TNameSelf
+
+	Name string `json:"name"`
+}
+
+func Test_NewFieldNamer(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		// --- When ---
+		have := NewFieldNamer(TNameUser{})
+
+		// --- Then ---
+		assert.Equal(t, reflect.TypeOf(TNameUser{}), have.typ)
+		assert.Equal(t, DefaultFieldTags, have.tags)
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		// --- When ---
+		have := NewFieldNamer(&TNameUser{}, "form")
+
+		// --- Then ---
+		assert.Equal(t, reflect.TypeOf(&TNameUser{}), have.typ)
+		assert.Equal(t, []string{"form"}, have.tags)
+	})
+
+	t.Run("type", func(t *testing.T) {
+		// --- Given ---
+		typ := reflect.TypeOf(TNameUser{})
+
+		// --- When ---
+		have := NewFieldNamer(typ)
+
+		// --- Then ---
+		assert.Equal(t, typ, have.typ)
+	})
+}
+
+func Test_FieldNamer_Name_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		field string
+		want  string
+	}{
+		{"json tag", "EmailAddress", "email_address"},
+		{"xrr tag first", "Nickname", "nick"},
+		{"dash tag", "Password", "Password"},
+		{"no tag", "Address.City", "address.City"},
+		{"pointer to struct", "Address.ZipCode", "address.zip"},
+		{"slice", "Items[3].Price", "items[3].price"},
+		{"array of slices", "Grid[1][0].Price", "grid[1][0].price"},
+		{"map", "Labels.home.ZipCode", "labels.home.zip"},
+		{"promoted", "Created", "created_at"},
+		{"embedded name", "TNameBase.Created", "created_at"},
+		{"shadowed promoted", "ID", "user_id"},
+		{"embedded with tag", "TNameMeta.Source", "meta.source"},
+		{"unknown field", "Other.ZipCode", "Other.ZipCode"},
+		{"field of non-struct", "EmailAddress.ZipCode", "email_address.ZipCode"},
+		{"index of non-slice", "Address[0]", "address[0]"},
+		{"unexported", "private", "private"},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			fn := NewFieldNamer(TNameUser{})
+
+			// --- When ---
+			have := fn.Name(tc.field)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_FieldNamer_Name(t *testing.T) {
+	t.Run("custom tags", func(t *testing.T) {
+		// --- Given ---
+		fn := NewFieldNamer(TNameUser{}, "form")
+
+		// --- When ---
+		have := fn.Name("Address.ZipCode")
+
+		// --- Then ---
+		assert.Equal(t, "Address.zip_code", have)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		// --- Given ---
+		fn := NewFieldNamer(nil)
+
+		// --- When ---
+		have := fn.Name("Address.ZipCode")
+
+		// --- Then ---
+		assert.Equal(t, "Address.ZipCode", have)
+	})
+
+	t.Run("promoted fields with the same name at the same depth", func(t *testing.T) {
+		// --- Given ---
+		fn := NewFieldNamer(TNameSameDepth{})
+
+		// --- When ---
+		code := fn.Name("Code")
+		name := fn.Name("Name")
+
+		// --- Then ---
+		assert.Equal(t, "Code", code)
+		assert.Equal(t, "name", name)
+	})
+
+	t.Run("promoted fields with the same name at different depths", func(t *testing.T) {
+		// --- Given ---
+		fn := NewFieldNamer(TNameDifferentDepth{})
+
+		// --- When ---
+		code := fn.Name("Code")
+		name := fn.Name("Name")
+
+		// --- Then ---
+		assert.Equal(t, "code_a", code)
+		assert.Equal(t, "Name", name)
+	})
+
+	t.Run("struct embedding itself", func(t *testing.T) {
+		// --- Given ---
+		fn := NewFieldNamer(TNameSelf{})
+
+		// --- When ---
+		have := fn.Name("TNameSelf.Name")
+
+		// --- Then ---
+		assert.Equal(t, "name", have)
+	})
+}
+
+func Test_FieldNamer_Path(t *testing.T) {
+	// --- Given ---
+	fn := NewFieldNamer(TNameUser{})
+	pth := Path().Field("Items").Index(1).Field("Price")
+
+	// --- When ---
+	have := fn.Path(pth)
+
+	// --- Then ---
+	assert.Equal(t, []string{"items", "[1]", "price"}, have.segs)
+	assert.Equal(t, "Items[1].Price", pth.String())
+}
+
+func Test_FieldNamer_fields(t *testing.T) {
+	// --- Given ---
+	fn := NewFieldNamer(TNameUser{})
+	typ := reflect.TypeOf(TNameAddress{})
+
+	// --- When ---
+	have := fn.fields(typ)
+
+	// --- Then ---
+	want := map[string]structField{
+		"ZipCode": {name: "zip", typ: reflect.TypeOf("")},
+		"City":    {name: "City", typ: reflect.TypeOf("")},
+	}
+	assert.Equal(t, want, have)
+	cached, _ := fn.cache.Load(typ)
+	assert.Equal(t, want, cached)
+}
+
+func Test_NewStructFieldCollector(t *testing.T) {
+	t.Run("collects errors under tag names", func(t *testing.T) {
+		// --- Given ---
+		fc := NewStructFieldCollector[EDXrr](TNameUser{})
+
+		// --- When ---
+		fc.Add("EmailAddress", errors.New("invalid"))
+		fc.Field("Address").Add("ZipCode", errors.New("required"))
+		fc.Field("Items").Index(1).Add("Price", errors.New("negative"))
+
+		// --- Then ---
+		err := fc.Err()
+		want := "address.zip: required; email_address: invalid; items[1].price: negative"
+		assert.Equal(t, want, err.Error())
+		assert.Equal(t, "Items[1]", fc.Field("Items").Index(1).Path().String())
+	})
+
+	t.Run("add path", func(t *testing.T) {
+		// --- Given ---
+		fc := NewStructFieldCollector[EDXrr](&TNameUser{}, "form")
+
+		// --- When ---
+		fc.Field("Address").AddPath(Path().Field("ZipCode"), ErrTst)
+
+		// --- Then ---
+		assert.Equal(t, "Address.zip_code: std tst msg", fc.Err().Error())
+	})
+}
+
+func Test_RenameFields(t *testing.T) {
+	t.Run("flat keys", func(t *testing.T) {
+		// --- Given ---
+		e0, e1 := errors.New("em0"), errors.New("em1")
+		err := NewFieldErrors(map[string]error{
+			"EmailAddress":    e0,
+			"Address.ZipCode": e1,
+			"":                ErrTst,
+		})
+
+		// --- When ---
+		have := RenameFields[EDXrr](err, TNameUser{})
+
+		// --- Then ---
+		fs, _ := assert.SameType(t, &GenericFields[EDXrr]{}, have)
+		want := map[string]error{
+			"email_address": e0,
+			"address.zip":   e1,
+			"":              ErrTst,
+		}
+		assert.Equal(t, want, fs.fields)
+	})
+
+	t.Run("nested fields", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+		fs.SetPath(Path().Field("Items").Index(0).Field("Price"), errors.New("em0"))
+		fs.SetPath(Path().Field("Labels").Field("home").Field("ZipCode"), errors.New("em1"))
+		fs.AddField("Nickname", errors.New("em2"))
+		fs.AddField("Nickname", errors.New("em3"))
+
+		// --- When ---
+		have := RenameFields[EDXrr](fs, &TNameUser{})
+
+		// --- Then ---
+		want := "items[0].price: em0; labels.home.zip: em1; nick: em2; nick: em3"
+		assert.Equal(t, want, have.Error())
+		assert.NotNil(t, GetFieldError(have, "items[0].price"))
+		wantJSON := `{
+			"items[0].price": {"code": "ECGeneric", "error": "em0"},
+			"labels.home.zip": {"code": "ECGeneric", "error": "em1"},
+			"nick": [
+				{"code": "ECGeneric", "error": "em2"},
+				{"code": "ECGeneric", "error": "em3"}
+			]
+		}`
+		assert.JSON(t, wantJSON, string(must.Value(json.Marshal(have))))
+	})
+
+	t.Run("embedded struct fields", func(t *testing.T) {
+		// --- Given ---
+		e0, e1, e2 := errors.New("em0"), errors.New("em1"), errors.New("em2")
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("Nickname", e0)
+		fs.Set("TNameBase", NewFieldErrors(map[string]error{"ID": e1}))
+		fs.Set("TNameBase.Created", e2)
+
+		// --- When ---
+		have := RenameFields[EDXrr](fs, TNameUser{})
+
+		// --- Then ---
+		assert.Equal(t, "nick: em0; id: em1; created_at: em2", have.Error())
+		assert.Equal(t, []string{"nick", "id", "created_at"}, FieldNames(have))
+		assert.Same(t, e1, GetFieldError(have, "id"))
+	})
+
+	t.Run("embedded struct error", func(t *testing.T) {
+		// --- Given ---
+		e0, e1 := errors.New("em0"), errors.New("em1")
+		err := NewFieldErrors(map[string]error{"": e0, "TNameBase": e1})
+
+		// --- When ---
+		have := RenameFields[EDXrr](err, TNameUser{})
+
+		// --- Then ---
+		fs, _ := assert.SameType(t, &GenericFields[EDXrr]{}, have)
+		assert.Equal(t, map[string]error{"": fieldErrorList{e0, e1}}, fs.fields)
+	})
+
+	t.Run("embedded struct fields with same names", func(t *testing.T) {
+		// --- Given ---
+		e0, e1 := errors.New("em0"), errors.New("em1")
+		err := NewFieldErrors(map[string]error{
+			"TNameBase":    NewFieldErrors(map[string]error{"ID": e0}),
+			"TNameBase.ID": e1,
+		})
+
+		// --- When ---
+		have := RenameFields[EDXrr](err, TNameUser{})
+
+		// --- Then ---
+		assert.Equal(t, "id: em0; id: em1", have.Error())
+	})
+
+	t.Run("consistent with struct field collector", func(t *testing.T) {
+		// --- Given ---
+		fc := NewStructFieldCollector[EDXrr](TNameUser{})
+		fc.Field("TNameBase").Add("ID", ErrTst)
+		err := NewFieldErrors(map[string]error{
+			"TNameBase": NewFieldErrors(map[string]error{"ID": ErrTst}),
+		})
+
+		// --- When ---
+		have := RenameFields[EDXrr](err, TNameUser{})
+
+		// --- Then ---
+		assert.Equal(t, fc.Err().Error(), have.Error())
+		assert.Equal(t, "id: std tst msg", have.Error())
+	})
+
+	t.Run("preserves order", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("Nickname", ErrTst)
+		fs.Set("EmailAddress", ErrTst)
+
+		// --- When ---
+		have := RenameFields[EDXrr](fs, TNameUser{})
+
+		// --- Then ---
+		assert.Equal(t, []string{"nick", "email_address"}, FieldNames(have))
+	})
+
+	t.Run("custom tags", func(t *testing.T) {
+		// --- Given ---
+		err := NewFieldError("Address", NewFieldError("ZipCode", ErrTst))
+
+		// --- When ---
+		have := RenameFields[EDXrr](err, TNameUser{}, "form")
+
+		// --- Then ---
+		assert.Equal(t, "Address.zip_code: std tst msg", have.Error())
+	})
+
+	t.Run("not fielder", func(t *testing.T) {
+		// --- When ---
+		have := RenameFields[EDXrr](ErrTst, TNameUser{})
+
+		// --- Then ---
+		assert.Same(t, ErrTst, have)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := RenameFields[EDXrr](nil, TNameUser{})
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
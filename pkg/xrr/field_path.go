@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FieldPath represents a path to a field in nested structures, for example,
+// "items[3].price". The zero value is the empty path. Instances are
+// immutable, methods return new instances, so the same path may be used as
+// the base for many paths.
+type FieldPath struct {
+	segs []string // Field names and indexes in square brackets.
+}
+
+// Path returns a new empty [FieldPath].
+//
+//	xrr.Path().Field("items").Index(3).Field("price").String() // items[3].price
+func Path() FieldPath { return FieldPath{} }
+
+// Field returns a new path with the field name appended. Empty names are
+// ignored.
+func (p FieldPath) Field(name string) FieldPath {
+	if name == "" {
+		return p
+	}
+	return FieldPath{segs: append(slices.Clip(p.segs), name)}
+}
+
+// Index returns a new path with the slice or array index appended.
+func (p FieldPath) Index(idx int) FieldPath {
+	seg := "[" + strconv.Itoa(idx) + "]"
+	return FieldPath{segs: append(slices.Clip(p.segs), seg)}
+}
+
+// Join returns a new path with the segments of the other path appended.
+func (p FieldPath) Join(other FieldPath) FieldPath {
+	return FieldPath{segs: append(slices.Clip(p.segs), other.segs...)}
+}
+
+// Segments returns the path segments. Field names are returned as is and
+// indexes in square brackets, for example, ["items", "[3]", "price"].
+func (p FieldPath) Segments() []string { return slices.Clone(p.segs) }
+
+// IsZero returns true if the path is empty.
+func (p FieldPath) IsZero() bool { return len(p.segs) == 0 }
+
+// String returns the path in the notation used by [GenericFields] keys, the
+// field names are separated by dots and the indexes are in square brackets.
+func (p FieldPath) String() string {
+	var s string
+	for _, seg := range p.segs {
+		s = prefix(s, seg)
+	}
+	return s
+}
+
+// ParseFieldPath parses the field path in any of the notations used by the
+// [FieldStyle] styles, for example, "items[3].price", "items[3][price]" or
+// "/items/3/price". Bracketed segments consisting of digits and the JSON
+// pointer segments consisting of digits are parsed as indexes.
+func ParseFieldPath(s string) FieldPath {
+	var p FieldPath
+	if ptr, ok := strings.CutPrefix(s, "/"); ok {
+		for _, seg := range strings.Split(ptr, "/") {
+			seg = pointerUnescape.Replace(seg)
+			if idx, ok := parseIndex(seg); ok {
+				p = p.Index(idx)
+				continue
+			}
+			p = p.Field(seg)
+		}
+		return p
+	}
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return p.Field(s)
+			}
+			if idx, ok := parseIndex(s[1:end]); ok {
+				p = p.Index(idx)
+			} else {
+				p = p.Field(s[1:end])
+			}
+			s = s[end+1:]
+
+		default:
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			p = p.Field(s[:end])
+			s = s[end:]
+		}
+	}
+	return p
+}
+
+// Bracket returns the path in the bracket notation, for example,
+// "items[3][price]".
+func (p FieldPath) Bracket() string {
+	var b strings.Builder
+	for i, seg := range p.segs {
+		if i == 0 || isIndexSeg(seg) {
+			b.WriteString(seg)
+			continue
+		}
+		b.WriteString("[" + seg + "]")
+	}
+	return b.String()
+}
+
+// Pointer returns the path as the JSON pointer (RFC 6901), for example,
+// "/items/3/price". Returns an empty string for the empty path.
+func (p FieldPath) Pointer() string {
+	var b strings.Builder
+	for _, seg := range p.segs {
+		b.WriteByte('/')
+		b.WriteString(pointerEscape.Replace(segName(seg)))
+	}
+	return b.String()
+}
+
+// JSON pointer escaping (RFC 6901).
+var (
+	pointerEscape   = strings.NewReplacer("~", "~0", "/", "~1")
+	pointerUnescape = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// isIndexSeg returns true if the path segment is the index.
+func isIndexSeg(seg string) bool {
+	return len(seg) > 2 && seg[0] == '[' && seg[len(seg)-1] == ']'
+}
+
+// segName returns the path segment name, the index without square brackets
+// for index segments.
+func segName(seg string) string {
+	if isIndexSeg(seg) {
+		return seg[1 : len(seg)-1]
+	}
+	return seg
+}
+
+// parseIndex parses the string consisting of digits as the index.
+func parseIndex(s string) (int, bool) {
+	if s == "" || strings.Trim(s, "0123456789") != "" {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	return idx, true
+}
+
+// FieldCollector collects field errors at paths relative to its scope and
+// builds nested [GenericFields] from them. Scoped collectors created with
+// [FieldCollector.Field] and [FieldCollector.Index] share the collected
+// errors with the collector they were created from:
+//
+//	fc := xrr.NewFieldCollector[edOrder]()
+//	for i, item := range order.Items {
+//	    ic := fc.Field("items").Index(i)
+//	    if item.Price < 0 {
+//	        ic.Add("price", ErrNegative)
+//	    }
+//	}
+//	err := fc.Err() // items[3].price: negative value
+//
+// It is not safe for concurrent use.
+type FieldCollector[T Domain] struct {
+	root  *GenericFields[T] // Collected errors.
+	path  FieldPath         // Collector scope.
+	namer *FieldNamer       // Resolves Go field names, nil when not used.
+}
+
+// NewFieldCollector returns a new instance of [FieldCollector].
+func NewFieldCollector[T Domain]() *FieldCollector[T] {
+	return &FieldCollector[T]{root: &GenericFields[T]{}}
+}
+
+// NewOrderedFieldCollector returns a new instance of [FieldCollector]
+// which reports the fields in the order they were added (see
+// [NewOrderedFields]).
+func NewOrderedFieldCollector[T Domain]() *FieldCollector[T] {
+	return &FieldCollector[T]{root: NewOrderedFields[T]()}
+}
+
+// Field returns a collector scoped to the field.
+func (fc *FieldCollector[T]) Field(name string) *FieldCollector[T] {
+	return &FieldCollector[T]{root: fc.root, path: fc.path.Field(name), namer: fc.namer}
+}
+
+// Index returns a collector scoped to the slice or array index.
+func (fc *FieldCollector[T]) Index(idx int) *FieldCollector[T] {
+	return &FieldCollector[T]{root: fc.root, path: fc.path.Index(idx), namer: fc.namer}
+}
+
+// Path returns the collector scope. For collectors created with
+// [NewStructFieldCollector], the scope consists of the Go field names.
+func (fc *FieldCollector[T]) Path() FieldPath { return fc.path }
+
+// Add adds the error for the field relative to the collector scope. The
+// empty field name adds the error for the scope itself, at the root scope it
+// is added for the empty field name, which is reported without the field
+// name prefix. The errors added for the same field are all kept (see
+// [GenericFields.AddField]). The nil errors are ignored.
+func (fc *FieldCollector[T]) Add(field string, err error) {
+	fc.AddPath(Path().Field(field), err)
+}
+
+// AddPath adds the error for the path relative to the collector scope. The
+// empty path at the root scope works like [FieldCollector.Add] with the empty
+// field name. The nil errors are ignored. See [GenericFields.AddPath].
+func (fc *FieldCollector[T]) AddPath(pth FieldPath, err error) {
+	pth = fc.path.Join(pth)
+	if fc.namer != nil {
+		pth = fc.namer.Path(pth)
+	}
+	if pth.IsZero() {
+		if err != nil {
+			fc.root.AddField("", err)
+		}
+		return
+	}
+	fc.root.AddPath(pth, err)
+}
+
+// Err returns the collected errors as [GenericFields] or nil if there are
+// none. The errors collected by all scoped collectors are returned.
+func (fc *FieldCollector[T]) Err() error { return fc.root.Filter() }
@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_Path(t *testing.T) {
+	// --- When ---
+	have := Path()
+
+	// --- Then ---
+	assert.True(t, have.IsZero())
+	assert.Equal(t, "", have.String())
+}
+
+func Test_FieldPath_Field(t *testing.T) {
+	t.Run("field", func(t *testing.T) {
+		// --- When ---
+		have := Path().Field("a").Field("b")
+
+		// --- Then ---
+		assert.Equal(t, []string{"a", "b"}, have.segs)
+	})
+
+	t.Run("empty name is ignored", func(t *testing.T) {
+		// --- When ---
+		have := Path().Field("a").Field("")
+
+		// --- Then ---
+		assert.Equal(t, []string{"a"}, have.segs)
+	})
+
+	t.Run("base path is not modified", func(t *testing.T) {
+		// --- Given ---
+		base := Path().Field("a").Field("b").Field("c")
+		base = FieldPath{segs: base.segs[:2]}
+
+		// --- When ---
+		p0 := base.Field("x")
+		p1 := base.Field("y")
+
+		// --- Then ---
+		assert.Equal(t, "a.b", base.String())
+		assert.Equal(t, "a.b.x", p0.String())
+		assert.Equal(t, "a.b.y", p1.String())
+	})
+}
+
+func Test_FieldPath_Index(t *testing.T) {
+	// --- When ---
+	have := Path().Field("items").Index(3).Index(0)
+
+	// --- Then ---
+	assert.Equal(t, []string{"items", "[3]", "[0]"}, have.segs)
+}
+
+func Test_FieldPath_Join(t *testing.T) {
+	// --- Given ---
+	base := Path().Field("items")
+
+	// --- When ---
+	have := base.Join(Path().Index(1).Field("price"))
+
+	// --- Then ---
+	assert.Equal(t, "items[1].price", have.String())
+	assert.Equal(t, "items", base.String())
+}
+
+func Test_FieldPath_Segments(t *testing.T) {
+	// --- Given ---
+	pth := Path().Field("items").Index(3)
+
+	// --- When ---
+	have := pth.Segments()
+
+	// --- Then ---
+	assert.Equal(t, []string{"items", "[3]"}, have)
+	have[0] = "other"
+	assert.Equal(t, "items[3]", pth.String())
+}
+
+func Test_FieldPath_IsZero(t *testing.T) {
+	assert.True(t, FieldPath{}.IsZero())
+	assert.False(t, Path().Index(0).IsZero())
+}
+
+func Test_FieldPath_String_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pth  FieldPath
+		want string
+	}{
+		{"empty", Path(), ""},
+		{"field", Path().Field("a"), "a"},
+		{"fields", Path().Field("a").Field("b"), "a.b"},
+		{"index", Path().Index(1), "[1]"},
+		{"field index", Path().Field("a").Index(1), "a[1]"},
+		{"index field", Path().Index(1).Field("a"), "[1].a"},
+		{"nested", Path().Field("a").Index(1).Index(2).Field("b"), "a[1][2].b"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.pth.String()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_ParseFieldPath_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pth  string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"field", "a", []string{"a"}},
+		{"dot", "a.b", []string{"a", "b"}},
+		{"dot index", "items[3].price", []string{"items", "[3]", "price"}},
+		{"bracket", "items[3][price]", []string{"items", "[3]", "price"}},
+		{"leading index", "[3].a", []string{"[3]", "a"}},
+		{"index after index", "a[1][2]", []string{"a", "[1]", "[2]"}},
+		{"empty brackets", "a[]", []string{"a"}},
+		{"negative index", "a[-1]", []string{"a", "-1"}},
+		{"unclosed bracket", "a[b", []string{"a", "[b"}},
+		{"pointer", "/items/3/price", []string{"items", "[3]", "price"}},
+		{"pointer escaped", "/a~1b/c~0d", []string{"a/b", "c~d"}},
+		{"pointer root", "/", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := ParseFieldPath(tc.pth)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have.segs)
+		})
+	}
+}
+
+func Test_FieldPath_Bracket_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pth  FieldPath
+		want string
+	}{
+		{"empty", Path(), ""},
+		{"field", Path().Field("a"), "a"},
+		{"fields", Path().Field("a").Field("b"), "a[b]"},
+		{"index", Path().Index(1), "[1]"},
+		{"nested", Path().Field("a").Index(1).Field("b"), "a[1][b]"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.pth.Bracket()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_FieldPath_Pointer_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pth  FieldPath
+		want string
+	}{
+		{"empty", Path(), ""},
+		{"field", Path().Field("a"), "/a"},
+		{"nested", Path().Field("a").Index(1).Field("b"), "/a/1/b"},
+		{"escaped", Path().Field("a/b").Field("c~d"), "/a~1b/c~0d"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.pth.Pointer()
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_parseIndex_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		s    string
+		want int
+		ok   bool
+	}{
+		{"digits", "12", 12, true},
+		{"zero", "0", 0, true},
+		{"empty", "", 0, false},
+		{"sign", "-1", 0, false},
+		{"letters", "1a", 0, false},
+		{"overflow", "99999999999999999999", 0, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, ok := parseIndex(tc.s)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+}
+
+func Test_NewFieldCollector(t *testing.T) {
+	// --- When ---
+	have := NewFieldCollector[EDXrr]()
+
+	// --- Then ---
+	assert.NotNil(t, have.root)
+	assert.True(t, have.path.IsZero())
+	assert.NoError(t, have.Err())
+}
+
+func Test_NewOrderedFieldCollector(t *testing.T) {
+	// --- Given ---
+	fc := NewOrderedFieldCollector[EDXrr]()
+
+	// --- When ---
+	fc.Add("name", errors.New("required"))
+	fc.Field("items").Index(1).Add("qty", errors.New("zero"))
+	fc.Field("items").Index(0).Add("price", errors.New("negative"))
+
+	// --- Then ---
+	want := "name: required; items[1].qty: zero; items[0].price: negative"
+	assert.Equal(t, want, fc.Err().Error())
+}
+
+func Test_FieldCollector_Field(t *testing.T) {
+	// --- Given ---
+	fc := NewFieldCollector[EDXrr]()
+
+	// --- When ---
+	have := fc.Field("a").Field("b")
+
+	// --- Then ---
+	assert.Same(t, fc.root, have.root)
+	assert.Equal(t, "a.b", have.Path().String())
+	assert.True(t, fc.Path().IsZero())
+}
+
+func Test_FieldCollector_Index(t *testing.T) {
+	// --- Given ---
+	fc := NewFieldCollector[EDXrr]()
+
+	// --- When ---
+	have := fc.Field("items").Index(2)
+
+	// --- Then ---
+	assert.Same(t, fc.root, have.root)
+	assert.Equal(t, "items[2]", have.Path().String())
+}
+
+func Test_FieldCollector_Add(t *testing.T) {
+	t.Run("add", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+		ic := fc.Field("items").Index(3)
+
+		// --- When ---
+		ic.Add("price", ErrTst)
+
+		// --- Then ---
+		err := fc.Err()
+		assert.Same(t, ErrTst, GetFieldError(err, "items[3].price"))
+		assert.Equal(t, "items[3].price: std tst msg", err.Error())
+	})
+
+	t.Run("empty field name adds error for the scope", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+
+		// --- When ---
+		fc.Field("items").Index(3).Add("", ErrTst)
+
+		// --- Then ---
+		assert.Equal(t, "items[3]: std tst msg", fc.Err().Error())
+	})
+
+	t.Run("empty field name at the root scope", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+
+		// --- When ---
+		fc.Add("", ErrTst)
+		fc.Add("name", errors.New("required"))
+
+		// --- Then ---
+		err := fc.Err()
+		assert.Same(t, ErrTst, GetFieldError(err, ""))
+		assert.Equal(t, "std tst msg; name: required", err.Error())
+		assert.ErrorIs(t, ErrTst, err)
+	})
+
+	t.Run("keeps all errors for the field", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+		ic := fc.Field("items").Index(0)
+
+		// --- When ---
+		ic.Add("price", errors.New("negative"))
+		ic.Add("price", errors.New("too large"))
+
+		// --- Then ---
+		want := "items[0].price: negative; items[0].price: too large"
+		assert.Equal(t, want, fc.Err().Error())
+	})
+
+	t.Run("nil error is ignored", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+
+		// --- When ---
+		fc.Field("items").Add("price", nil)
+
+		// --- Then ---
+		assert.NoError(t, fc.Err())
+	})
+}
+
+func Test_FieldCollector_AddPath(t *testing.T) {
+	// --- Given ---
+	fc := NewFieldCollector[EDXrr]()
+	ic := fc.Field("items")
+
+	// --- When ---
+	ic.AddPath(Path().Index(0).Field("price"), ErrTst)
+	ic.AddPath(Path().Index(1).Field("qty"), nil)
+
+	// --- Then ---
+	assert.Equal(t, "items[0].price: std tst msg", fc.Err().Error())
+}
+
+func Test_FieldCollector_Err(t *testing.T) {
+	t.Run("no errors", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+
+		// --- When ---
+		err := fc.Err()
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("nested fields", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+		fc.Add("name", errors.New("required"))
+		fc.Field("items").Index(0).Add("price", New("negative", "ECNeg"))
+		fc.Field("items").Index(1).Add("qty", errors.New("zero"))
+
+		// --- When ---
+		err := fc.Err()
+
+		// --- Then ---
+		want := "items[0].price: negative; items[1].qty: zero; name: required"
+		assert.Equal(t, want, err.Error())
+		want = `{
+			"items[0].price": {"code": "ECNeg", "error": "negative"},
+			"items[1].qty": {"code": "ECGeneric", "error": "zero"},
+			"name": {"code": "ECGeneric", "error": "required"}
+		}`
+		assert.JSON(t, want, string(must.Value(json.Marshal(err))))
+	})
+}
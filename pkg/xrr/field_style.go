@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+)
+
+// FieldStyle represents the style of field errors in the JSON representation
+// of [GenericFields] and the "fields" key of [Envelope]. See
+// [WithJSONFieldStyle].
+//
+// In all the styles except [FieldStyleList], the fields with multiple errors
+// (see [GenericFields.AddField]) are rendered as arrays of errors:
+//
+//	{"password": [
+//	  {"code": "ECShort", "error": "too short"},
+//	  {"code": "ECDigit", "error": "no digit"}
+//	]}
+type FieldStyle int
+
+// Field error styles. For the "items[3].price" field:
+const (
+	// FieldStyleDot is the default style with dot separated keys and indexes
+	// in square brackets:
+	//
+	//	{"items[3].price": {"code": "ECode", "error": "message"}}
+	FieldStyleDot FieldStyle = iota
+
+	// FieldStyleBracket is the style with all path segments in square
+	// brackets except the first one:
+	//
+	//	{"items[3][price]": {"code": "ECode", "error": "message"}}
+	FieldStyleBracket
+
+	// FieldStylePointer is the style with JSON pointer (RFC 6901) keys:
+	//
+	//	{"/items/3/price": {"code": "ECode", "error": "message"}}
+	FieldStylePointer
+
+	// FieldStyleNested is the style with nested objects mirroring the
+	// structure of the request body. Indexes are rendered as object keys.
+	// The error of the field which also has nested field errors is rendered
+	// under the empty key:
+	//
+	//	{"items": {"3": {"price": {"code": "ECode", "error": "message"}}}}
+	FieldStyleNested
+
+	// FieldStyleList is the style with the array of errors in the field
+	// order (see [NewOrderedFields]), each with the "field" key in the
+	// [FieldStyleDot] notation. Fields with multiple errors have an entry
+	// for each of them:
+	//
+	//	[{"field": "items[3].price", "code": "ECode", "error": "message"}]
+	FieldStyleList
+)
+
+// encodeFields returns the JSON representation of the flat map of field
+// errors with the names in the style configured with ops. The names are
+// the names of the non-nil field errors in the field order. When ordered is
+// false, the object keys are sorted alphabetically.
+func encodeFields(
+	names []string,
+	fields map[string]error,
+	ordered bool,
+	ops JSONOptions,
+) ([]byte, error) {
+
+	switch ops.fieldStyle {
+	case FieldStyleNested:
+		return encodeFieldsNested(names, fields, ordered, ops)
+	case FieldStyleList:
+		return encodeFieldsList(names, fields, ops)
+	}
+	ret := newJSONObject(len(names), !ordered)
+	for _, name := range names {
+		data, err := marshalField(fields[name], ops)
+		if err != nil {
+			return nil, err
+		}
+		ret.set(fieldKey(name, ops.fieldStyle), json.RawMessage(data))
+	}
+	return json.Marshal(ret)
+}
+
+// fieldKey returns the field key in the style.
+func fieldKey(field string, style FieldStyle) string {
+	switch style {
+	case FieldStyleBracket:
+		return ParseFieldPath(field).Bracket()
+	case FieldStylePointer:
+		return ParseFieldPath(field).Pointer()
+	}
+	return field
+}
+
+// encodeFieldsNested returns the JSON representation of the flat map of
+// field errors in the [FieldStyleNested] style. See [encodeFields].
+func encodeFieldsNested(
+	names []string,
+	fields map[string]error,
+	ordered bool,
+	ops JSONOptions,
+) ([]byte, error) {
+
+	root := newJSONObject(len(names), !ordered)
+	for _, name := range names {
+		data, err := marshalField(fields[name], ops)
+		if err != nil {
+			return nil, err
+		}
+		node := root
+		segs := ParseFieldPath(name).segs
+		for i, seg := range segs {
+			key := segName(seg)
+			prev, exists := node.vals[key]
+			child, isObj := prev.(*jsonObject)
+			if i == len(segs)-1 {
+				if isObj {
+					child.set("", json.RawMessage(data))
+					break
+				}
+				node.set(key, json.RawMessage(data))
+				break
+			}
+			if !isObj {
+				child = newJSONObject(1, !ordered)
+				if exists {
+					child.set("", prev)
+				}
+				node.set(key, child)
+			}
+			node = child
+		}
+		if len(segs) == 0 {
+			root.set("", json.RawMessage(data))
+		}
+	}
+	return json.Marshal(root)
+}
+
+// encodeFieldsList returns the JSON representation of the flat map of field
+// errors in the [FieldStyleList] style. See [encodeFields].
+func encodeFieldsList(names []string, fields map[string]error, ops JSONOptions) ([]byte, error) {
+	ret := make([]map[string]json.RawMessage, 0, len(names))
+	for _, name := range names {
+		for _, fe := range fieldErrs(fields[name]) {
+			data, err := marshalField(fe, ops)
+			if err != nil {
+				return nil, err
+			}
+			var entry map[string]json.RawMessage
+			if err = json.Unmarshal(data, &entry); err != nil {
+				return nil, err
+			}
+			entry["field"] = json.RawMessage(strconv.Quote(name))
+			ret = append(ret, entry)
+		}
+	}
+	return json.Marshal(ret)
+}
+
+// jsonObject represents the JSON object which keys are encoded in the
+// insertion order or sorted alphabetically.
+type jsonObject struct {
+	keys   []string       // Keys in the insertion order.
+	vals   map[string]any // Values by key.
+	sorted bool           // Encode keys sorted alphabetically.
+}
+
+// newJSONObject returns a new instance of [jsonObject] with the capacity for
+// n keys.
+func newJSONObject(n int, sorted bool) *jsonObject {
+	return &jsonObject{
+		keys:   make([]string, 0, n),
+		vals:   make(map[string]any, n),
+		sorted: sorted,
+	}
+}
+
+// set sets the key to the value.
+func (obj *jsonObject) set(key string, value any) {
+	if _, ok := obj.vals[key]; !ok {
+		obj.keys = append(obj.keys, key)
+	}
+	obj.vals[key] = value
+}
+
+func (obj *jsonObject) MarshalJSON() ([]byte, error) {
+	keys := obj.keys
+	if obj.sorted {
+		keys = slices.Sorted(slices.Values(keys))
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		data, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+		buf.WriteByte(':')
+		if data, err = json.Marshal(obj.vals[key]); err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeFields decodes the JSON representation of field errors in any of
+// the [FieldStyle] styles. The returned map is flat with the keys in the
+// [FieldStyleDot] notation. Fields with multiple errors are decoded as
+// added with [GenericFields.AddField].
+func decodeFields[T Domain](data []byte) (map[string]error, error) {
+	if len(data) > 0 && data[0] == '[' {
+		var entries []json.RawMessage
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, err
+		}
+		fields := make(map[string]error, len(entries))
+		for _, entry := range entries {
+			var field struct {
+				Field string `json:"field"`
+			}
+			if err := json.Unmarshal(entry, &field); err != nil {
+				return nil, err
+			}
+			e, err := decodeError[T](entry)
+			if err != nil {
+				return nil, err
+			}
+			key := ParseFieldPath(field.Field).String()
+			fields[key] = addFieldError(fields[key], e)
+		}
+		return fields, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	fields := make(map[string]error, len(raw))
+	for key, value := range raw {
+		if err := decodeField[T](fields, ParseFieldPath(key), value); err != nil {
+			return nil, err
+		}
+	}
+	return fields, nil
+}
+
+// decodeField decodes the field error at the path and adds it to the flat
+// fields map. Objects without the "error" key with a string value are
+// decoded as the [FieldStyleNested] nested objects. Arrays are decoded as
+// multiple errors for the field.
+func decodeField[T Domain](fields map[string]error, pth FieldPath, data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		var entries []json.RawMessage
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return err
+		}
+		key := pth.String()
+		for _, entry := range entries {
+			e, err := decodeError[T](entry)
+			if err != nil {
+				return err
+			}
+			fields[key] = addFieldError(fields[key], e)
+		}
+		return nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if msg := raw["error"]; len(msg) > 0 && msg[0] == '"' || len(raw) == 0 {
+		e, err := decodeError[T](data)
+		if err != nil {
+			return err
+		}
+		fields[pth.String()] = e
+		return nil
+	}
+	for key, value := range raw {
+		sub := pth.Field(key)
+		if idx, ok := parseIndex(key); ok {
+			sub = pth.Index(idx)
+		}
+		if err := decodeField[T](fields, sub, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
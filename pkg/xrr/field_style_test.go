@@ -0,0 +1,471 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_encodeFields_tabular(t *testing.T) {
+	fields := map[string]error{
+		"items[3].price": New("negative", "ECNeg"),
+		"name":           errors.New("required"),
+	}
+	names := []string{"items[3].price", "name"}
+
+	tt := []struct {
+		testN string
+
+		style FieldStyle
+		want  string
+	}{
+		{
+			"dot",
+			FieldStyleDot,
+			`{
+				"items[3].price": {"code": "ECNeg", "error": "negative"},
+				"name": {"code": "ECGeneric", "error": "required"}
+			}`,
+		},
+		{
+			"bracket",
+			FieldStyleBracket,
+			`{
+				"items[3][price]": {"code": "ECNeg", "error": "negative"},
+				"name": {"code": "ECGeneric", "error": "required"}
+			}`,
+		},
+		{
+			"pointer",
+			FieldStylePointer,
+			`{
+				"/items/3/price": {"code": "ECNeg", "error": "negative"},
+				"/name": {"code": "ECGeneric", "error": "required"}
+			}`,
+		},
+		{
+			"nested",
+			FieldStyleNested,
+			`{
+				"items": {"3": {"price": {"code": "ECNeg", "error": "negative"}}},
+				"name": {"code": "ECGeneric", "error": "required"}
+			}`,
+		},
+		{
+			"list",
+			FieldStyleList,
+			`[
+				{"field": "items[3].price", "code": "ECNeg", "error": "negative"},
+				{"field": "name", "code": "ECGeneric", "error": "required"}
+			]`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			ops := JSONOptions{fieldStyle: tc.style}
+
+			// --- When ---
+			have, err := encodeFields(names, fields, false, ops)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.JSON(t, tc.want, string(have))
+		})
+	}
+}
+
+func Test_encodeFields(t *testing.T) {
+	t.Run("error - marshaling field error", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{"f0": &TErrMarshalJSON{err: errors.New("msg a")}}
+
+		for _, style := range []FieldStyle{FieldStyleDot, FieldStyleNested, FieldStyleList} {
+			// --- When ---
+			ops := JSONOptions{fieldStyle: style}
+			have, err := encodeFields([]string{"f0"}, fields, false, ops)
+
+			// --- Then ---
+			assert.ErrorContain(t, "msg a", err)
+			assert.Nil(t, have)
+		}
+	})
+}
+
+func Test_fieldKey_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		field string
+		style FieldStyle
+		want  string
+	}{
+		{"dot", "a[1].b", FieldStyleDot, "a[1].b"},
+		{"bracket", "a[1].b", FieldStyleBracket, "a[1][b]"},
+		{"pointer", "a[1].b", FieldStylePointer, "/a/1/b"},
+		{"nested", "a[1].b", FieldStyleNested, "a[1].b"},
+		{"list", "a[1].b", FieldStyleList, "a[1].b"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := fieldKey(tc.field, tc.style)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_encodeFieldsNested(t *testing.T) {
+	t.Run("field with nested field errors", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{
+			"items":      errors.New("too many"),
+			"items[0].a": errors.New("em0"),
+			"items[0]":   errors.New("em1"),
+		}
+		names := []string{"items", "items[0].a", "items[0]"}
+
+		// --- When ---
+		have, err := encodeFieldsNested(names, fields, false, JSONOptions{})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{
+			"items": {
+				"": {"code": "ECGeneric", "error": "too many"},
+				"0": {
+					"": {"code": "ECGeneric", "error": "em1"},
+					"a": {"code": "ECGeneric", "error": "em0"}
+				}
+			}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("empty field name", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{"": errors.New("em0")}
+
+		// --- When ---
+		have, err := encodeFieldsNested([]string{""}, fields, false, JSONOptions{})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.JSON(t, `{"": {"code": "ECGeneric", "error": "em0"}}`, string(have))
+	})
+}
+
+func Test_encodeFieldsList(t *testing.T) {
+	t.Run("in names order", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{
+			"b": New("em1", "EC1", Meta().Int("A", 1).Option()),
+			"a": errors.New("em0"),
+		}
+
+		// --- When ---
+		have, err := encodeFieldsList([]string{"a", "b"}, fields, JSONOptions{})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `[
+			{"field": "a", "code": "ECGeneric", "error": "em0"},
+			{"field": "b", "code": "EC1", "error": "em1", "meta": {"A": 1}}
+		]`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("field with multiple errors", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{
+			"a": fieldErrorList{errors.New("em0"), New("em1", "EC1")},
+		}
+
+		// --- When ---
+		have, err := encodeFieldsList([]string{"a"}, fields, JSONOptions{})
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `[
+			{"field": "a", "code": "ECGeneric", "error": "em0"},
+			{"field": "a", "code": "EC1", "error": "em1"}
+		]`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("error - not an object", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{"a": TErrMarshalJSONArray{}}
+
+		// --- When ---
+		have, err := encodeFieldsList([]string{"a"}, fields, JSONOptions{})
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_jsonObject_MarshalJSON(t *testing.T) {
+	t.Run("insertion order", func(t *testing.T) {
+		// --- Given ---
+		obj := newJSONObject(0, false)
+		obj.set("b", 1)
+		obj.set("a", "<x>")
+		obj.set("b", 2)
+
+		// --- When ---
+		have, err := json.Marshal(obj)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"b":2,"a":"\u003cx\u003e"}`, string(have))
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+		// --- Given ---
+		obj := newJSONObject(0, true)
+		obj.set("b", 1)
+		obj.set("a", 2)
+
+		// --- When ---
+		have, err := json.Marshal(obj)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":2,"b":1}`, string(have))
+		assert.Equal(t, []string{"b", "a"}, obj.keys)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have, err := json.Marshal(newJSONObject(0, false))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, `{}`, string(have))
+	})
+
+	t.Run("error - marshaling value", func(t *testing.T) {
+		// --- Given ---
+		obj := newJSONObject(0, false)
+		obj.set("a", func() {})
+
+		// --- When ---
+		have, err := json.Marshal(obj)
+
+		// --- Then ---
+		var target *json.UnsupportedTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_decodeFields(t *testing.T) {
+	t.Run("object", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"/a/0": {"code": "ECA", "error": "em0"},
+			"b[c]": {"code": "ECB", "error": "em1"}
+		}`)
+
+		// --- When ---
+		have, err := decodeFields[EDXrr](data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 2, have)
+		assert.ErrorEqual(t, "em0", have["a[0]"])
+		assert.Equal(t, "ECA", GetCode(have["a[0]"]))
+		assert.ErrorEqual(t, "em1", have["b.c"])
+	})
+
+	t.Run("list", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`[
+			{"field": "a[0]", "code": "ECA", "error": "em0"},
+			{"field": "/b/c", "code": "ECB", "error": "em1"}
+		]`)
+
+		// --- When ---
+		have, err := decodeFields[EDXrr](data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 2, have)
+		assert.ErrorEqual(t, "em0", have["a[0]"])
+		assert.ErrorEqual(t, "em1", have["b.c"])
+		assert.Equal(t, "ECB", GetCode(have["b.c"]))
+	})
+
+	t.Run("list with repeated field", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`[
+			{"field": "a", "code": "ECA", "error": "em0"},
+			{"field": "a", "code": "ECB", "error": "em1"}
+		]`)
+
+		// --- When ---
+		have, err := decodeFields[EDXrr](data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		ers := Split(have["a"])
+		assert.Len(t, 2, ers)
+		assert.ErrorEqual(t, "em0", ers[0])
+		assert.ErrorEqual(t, "em1", ers[1])
+	})
+
+	t.Run("error - invalid list", func(t *testing.T) {
+		// --- When ---
+		have, err := decodeFields[EDXrr]([]byte(`[1]`))
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid list entry", func(t *testing.T) {
+		// --- When ---
+		have, err := decodeFields[EDXrr]([]byte(`[{"field": "a"}]`))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvJSONError, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		// --- When ---
+		have, err := decodeFields[EDXrr]([]byte(`{!}`))
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_decodeField(t *testing.T) {
+	t.Run("error object", func(t *testing.T) {
+		// --- Given ---
+		fields := make(map[string]error)
+		data := []byte(`{"code": "ECA", "error": "em0"}`)
+
+		// --- When ---
+		err := decodeField[EDXrr](fields, Path().Field("a"), data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "em0", fields["a"])
+		assert.Equal(t, "ECA", GetCode(fields["a"]))
+	})
+
+	t.Run("nested objects", func(t *testing.T) {
+		// --- Given ---
+		fields := make(map[string]error)
+		data := []byte(`{
+			"": {"code": "ECA", "error": "em0"},
+			"0": {"error": {"error": "em1"}},
+			"b": {"c": {"error": "em2"}}
+		}`)
+
+		// --- When ---
+		err := decodeField[EDXrr](fields, Path().Field("a"), data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 3, fields)
+		assert.ErrorEqual(t, "em0", fields["a"])
+		assert.ErrorEqual(t, "em1", fields["a[0].error"])
+		assert.ErrorEqual(t, "em2", fields["a.b.c"])
+	})
+
+	t.Run("array", func(t *testing.T) {
+		// --- Given ---
+		fields := make(map[string]error)
+		data := []byte(`[{"code": "ECA", "error": "em0"}, {"error": "em1"}]`)
+
+		// --- When ---
+		err := decodeField[EDXrr](fields, Path().Field("a"), data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		ers := Split(fields["a"])
+		assert.Len(t, 2, ers)
+		assert.ErrorEqual(t, "em0", ers[0])
+		assert.Equal(t, "ECA", GetCode(ers[0]))
+		assert.ErrorEqual(t, "em1", ers[1])
+	})
+
+	t.Run("array with single error", func(t *testing.T) {
+		// --- Given ---
+		fields := make(map[string]error)
+		data := []byte(`[{"code": "ECA", "error": "em0"}]`)
+
+		// --- When ---
+		err := decodeField[EDXrr](fields, Path().Field("a"), data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "em0", fields["a"])
+		assert.Equal(t, "ECA", GetCode(fields["a"]))
+	})
+
+	t.Run("error - invalid array", func(t *testing.T) {
+		// --- Given ---
+		fields := make(map[string]error)
+
+		// --- When ---
+		err := decodeField[EDXrr](fields, Path().Field("a"), []byte(`[1]`))
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+	})
+
+	t.Run("error - invalid array JSON", func(t *testing.T) {
+		// --- Given ---
+		fields := make(map[string]error)
+
+		// --- When ---
+		err := decodeField[EDXrr](fields, Path().Field("a"), []byte(`[!]`))
+
+		// --- Then ---
+		assert.Error(t, err)
+	})
+
+	t.Run("error - not an object", func(t *testing.T) {
+		// --- Given ---
+		fields := make(map[string]error)
+
+		// --- When ---
+		err := decodeField[EDXrr](fields, Path().Field("a"), []byte(`1`))
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+	})
+
+	t.Run("error - invalid nested error", func(t *testing.T) {
+		// --- Given ---
+		fields := make(map[string]error)
+		data := []byte(`{"b": {"code": "ECB", "error": 1}}`)
+
+		// --- When ---
+		err := decodeField[EDXrr](fields, Path().Field("a"), data)
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+	})
+}
@@ -5,8 +5,10 @@ package xrr
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"maps"
+	"slices"
+	"strings"
 )
 
 // Compile time checks.
@@ -14,6 +16,10 @@ var (
 	_ error            = (*GenericError[EDXrr])(nil)
 	_ Coder            = (*GenericError[EDXrr])(nil)
 	_ Metadater        = (*GenericError[EDXrr])(nil)
+	_ Stacker          = (*GenericError[EDXrr])(nil)
+	_ Templater        = (*GenericError[EDXrr])(nil)
+	_ Domainer         = (*GenericError[EDXrr])(nil)
+	_ DomainError      = (*GenericError[EDXrr])(nil)
 	_ json.Marshaler   = (*GenericError[EDXrr])(nil)
 	_ json.Unmarshaler = (*GenericError[EDXrr])(nil)
 )
@@ -21,26 +27,148 @@ var (
 // GenericError represents a generic type for creating domain-specific errors.
 type GenericError[T Domain] struct {
 	msg  string         // Error message.
+	tpl  string         // Error message template.
 	code string         // Error code.
 	meta map[string]any // Structured metadata.
 	err  error          // Wrapped error.
+
+	// The msg is the complete error message, including the wrapped error's
+	// message, and is returned by Error as is.
+	verbatim bool
+
+	stack Stack // Call stack recorded when the error was created.
 }
 
 // ErrorFunc returns a function for creating domain-specific errors.
 func ErrorFunc[T Domain]() func(msg, code string, opts ...Option) *GenericError[T] {
 	return func(msg, code string, opts ...Option) *GenericError[T] {
-		ops := Options{code: code}.Set(opts...)
-		return &GenericError[T]{
-			msg:  msg,
-			code: ops.code,
-			meta: ops.meta,
-			err:  ops.err,
+		return newGenericError[T](1, msg, code, opts...)
+	}
+}
+
+// TemplateFunc returns a function for creating domain-specific errors with
+// messages rendered from templates. The template refers to the error's own
+// metadata (see [GenericError.MetaAll]) with the key name in curly braces:
+//
+//	newError := xrr.TemplateFunc[edUser]()
+//	err := newError("user {user_id} not found", "ECNotFound", xrr.Meta().Str("user_id", "u-1").Option())
+//	err.Error()         // user u-1 not found
+//	err.ErrorTemplate() // user {user_id} not found
+//
+// References to keys missing in the metadata are rendered as
+// "%!key(MISSING)". Literal braces are written as "{{" and "}}".
+func TemplateFunc[T Domain]() func(tpl, code string, opts ...Option) *GenericError[T] {
+	return func(tpl, code string, opts ...Option) *GenericError[T] {
+		return newTemplateError[T](1, tpl, code, opts...)
+	}
+}
+
+// newTemplateError creates a new [GenericError] instance with the message
+// rendered from the template. The skip is the number of stack frames between
+// the caller and this function.
+func newTemplateError[T Domain](skip int, tpl, code string, opts ...Option) *GenericError[T] {
+	e := newGenericError[T](skip+1, "", code, opts...)
+	e.msg = renderMessage(tpl, e.meta)
+	e.tpl = tpl
+	return e
+}
+
+// ErrorfFunc returns a function for creating domain-specific errors with
+// messages formatted according to the format specifier (see [fmt.Errorf]).
+//
+// Errors passed for the %w verbs become the wrapped errors, a single one is
+// the cause (see [WithCause]) and multiple ones are joined (see [Join]). The
+// error code, when empty, is inherited from the wrapped errors (see
+// [GetCode]). The message is the formatted string as is, it already
+// includes the messages of the wrapped errors.
+//
+// Arguments created with [Arg] are formatted as their values and the values
+// of supported types (see [MetaType]) are added to the error metadata.
+// Arguments of the [Option] type are applied to the error and are not used
+// for formatting:
+//
+//	newError := xrr.ErrorfFunc[edUser]()
+//	err := newError("ECNotFound", "user %v not found: %w", xrr.Arg("user_id", "u-1"), cause)
+//	err.Error()   // user u-1 not found: cause message
+//	err.MetaAll() // map[user_id:u-1]
+func ErrorfFunc[T Domain]() func(code, format string, args ...any) *GenericError[T] {
+	return func(code, format string, args ...any) *GenericError[T] {
+		return newErrorf[T](1, code, format, args...)
+	}
+}
+
+// newErrorf creates a new [GenericError] instance with the formatted message.
+// The skip is the number of stack frames between the caller and this
+// function.
+func newErrorf[T Domain](skip int, code, format string, args ...any) *GenericError[T] {
+	var opts []Option
+	var meta map[string]any
+	fargs := make([]any, 0, len(args))
+	for _, arg := range args {
+		switch x := arg.(type) {
+		case Option:
+			opts = append(opts, x)
+			continue
+		case NamedArg:
+			if isTypeSupported(x.value) {
+				if meta == nil {
+					meta = make(map[string]any, len(args))
+				}
+				meta[x.name] = x.value
+			}
+			arg = x.value
 		}
+		fargs = append(fargs, arg)
+	}
+
+	// The [fmt.Errorf] returns an error implementing "Unwrap() error" for
+	// a single %w verb and "Unwrap() []error" for multiple ones.
+	fe := fmt.Errorf(format, fargs...)
+	var cause error
+	switch x := fe.(type) { // nolint: errorlint
+	case interface{ Unwrap() error }:
+		cause = x.Unwrap()
+	case joined:
+		cause = Join(slices.Clone(x.Unwrap())...)
+	}
+
+	pre := []Option{WithMeta(meta)}
+	if cause != nil {
+		pre = append(pre, WithCause(cause))
+	}
+	e := newGenericError[T](skip+1, fe.Error(), code, append(pre, opts...)...)
+	e.verbatim = cause != nil
+	return e
+}
+
+// NamedArg represents a named argument for the [ErrorfFunc] functions.
+type NamedArg struct {
+	name  string // Metadata key.
+	value any    // Argument value.
+}
+
+// Arg returns a named argument for the [ErrorfFunc] functions. The argument
+// is formatted as the value, and the value is added to the error metadata
+// under the name if it is of a supported type (see [MetaType]).
+func Arg(name string, value any) NamedArg {
+	return NamedArg{name: name, value: value}
+}
+
+// newGenericError creates a new [GenericError] instance. The skip is the
+// number of stack frames between the caller and this function.
+func newGenericError[T Domain](skip int, msg, code string, opts ...Option) *GenericError[T] {
+	ops := Options{code: code}.Set(opts...)
+	return &GenericError[T]{
+		msg:   msg,
+		code:  ops.code,
+		meta:  ops.meta,
+		err:   ops.err,
+		stack: callers(skip, ops.stack),
 	}
 }
 
 func (e *GenericError[T]) Error() string {
-	if e.err != nil {
+	if e.err != nil && !e.verbatim {
 		em := errorMessage(e.err)
 		if e.msg != "" {
 			return e.msg + ": " + em
@@ -58,8 +186,26 @@ func (e *GenericError[T]) ErrorCode() string {
 	return e.code
 }
 
-// MetaAll returns a clone of the error's metadata.
-func (e *GenericError[T]) MetaAll() map[string]any { return maps.Clone(e.meta) }
+// MetaAll returns a deep copy of the error's metadata.
+func (e *GenericError[T]) MetaAll() map[string]any { return cloneMeta(e.meta) }
+
+// ErrorStack returns the call stack recorded when the error was created or nil
+// if the stack was not recorded.
+func (e *GenericError[T]) ErrorStack() Stack { return e.stack }
+
+// ErrorTemplate returns the message template or an empty string if the error
+// was not created from a template. See [TemplateFunc].
+func (e *GenericError[T]) ErrorTemplate() string { return e.tpl }
+
+// ownMessage returns the error message without the wrapped error's message.
+func (e *GenericError[T]) ownMessage() string { return e.msg }
+
+// ErrorDomain returns the name of the error domain. See [DomainNamer].
+func (e *GenericError[T]) ErrorDomain() string { return domainName[T]() }
+
+// namedDomain returns the name of the error domain if the domain marker type
+// implements [DomainNamer]. Otherwise, it returns an empty string.
+func (e *GenericError[T]) namedDomain() string { return namedDomain[T]() }
 
 // Unwrap returns the wrapped error.
 func (e *GenericError[T]) Unwrap() error {
@@ -70,14 +216,12 @@ func (e *GenericError[T]) Unwrap() error {
 }
 
 func (e *GenericError[T]) MarshalJSON() ([]byte, error) {
-	m := map[string]any{
-		"error": e.Error(),
-		"code":  e.ErrorCode(),
-	}
-	if meta := GetMeta(e); len(meta) > 0 {
-		m["meta"] = meta
-	}
-	return json.Marshal(m)
+	return e.encodeJSON(JSONOptions{})
+}
+
+// encodeJSON returns JSON representation of the error configured with ops.
+func (e *GenericError[T]) encodeJSON(ops JSONOptions) ([]byte, error) {
+	return json.Marshal(errorAsMap(e, ops))
 }
 
 // UnmarshalJSON unmarshals JSON representation of the [GenericError].
@@ -89,40 +233,167 @@ func (e *GenericError[T]) MarshalJSON() ([]byte, error) {
 // and in this case, the error code is set to [ECGeneric].
 //
 // Notes:
-//   - Numeric values will be unmarshalled as float64.
+//   - Without the "meta_types" object (see [WithJSONMetaTypes]), numeric
+//     values will be unmarshalled as float64, [time.Time] and
+//     [time.Duration] values as string and float64 respectively.
+//   - With the "meta_types" object, metadata values are restored with their
+//     original types.
+//   - With the "cause" key (see [WithJSONCauses]), the wrapped errors are
+//     decoded as [GenericError] and [GenericFields] instances of domain T or
+//     of the domain registered with [RegisterDomain] for the "domain" key.
+//   - The "domain" key of the decoded error itself is ignored, use
+//     [DecodeJSON] to decode errors of the registered domains.
+//   - The "template" key (see [WithJSONTemplate]) restores the message
+//     template, the message itself is not rendered again.
 func (e *GenericError[T]) UnmarshalJSON(data []byte) error {
-	m := make(map[string]any, 3)
+	m := make(map[string]json.RawMessage, 4)
 	if err := json.Unmarshal(data, &m); err != nil {
 		return err
 	}
+	var cause error
+	if raw, ok := m["cause"]; ok {
+		var err error
+		if cause, err = decodeCause[T](raw); err != nil {
+			return err
+		}
+	}
+	return e.decode(m, cause)
+}
 
-	msgI := m["error"]
-	msg, _ := msgI.(string)
+// decode sets the error from the JSON object keys. The cause is the already
+// decoded wrapped error, it is used to derive the error's own message from
+// the complete one stored under the "error" key.
+func (e *GenericError[T]) decode(m map[string]json.RawMessage, cause error) error {
+	var msg string
+	_ = json.Unmarshal(m["error"], &msg)
 	if msg == "" {
 		return ErrInvJSONError
 	}
 
-	codeI := m["code"]
-	code, _ := codeI.(string)
+	var tpl string
+	_ = json.Unmarshal(m["template"], &tpl)
+
+	var code string
+	_ = json.Unmarshal(m["code"], &code)
 	if code == "" {
 		code = ECGeneric
 	}
 
-	metaI := m["meta"]
-	var meta map[string]any
-	if metaI != nil {
-		meta, _ = metaI.(map[string]any)
+	var raw, types map[string]json.RawMessage
+	_ = json.Unmarshal(m["meta"], &raw)
+	_ = json.Unmarshal(m["meta_types"], &types)
+	meta, err := decodeMeta(raw, types)
+	if err != nil {
+		return err
+	}
+
+	var verbatim bool
+	if cause != nil {
+		em := errorMessage(cause)
+		switch {
+		case msg == em:
+			msg = ""
+		case strings.HasSuffix(msg, ": "+em):
+			msg = strings.TrimSuffix(msg, ": "+em)
+		default:
+			verbatim = true
+		}
 	}
 
 	e.msg = msg
+	e.tpl = tpl
 	e.code = code
 	e.meta = meta
+	e.err = cause
+	e.verbatim = verbatim
 	return nil
 }
 
-// Format implements [fmt.Formatter] for [GenericError].
+// decodeCause decodes the error chain (tree) encoded with [WithJSONCauses].
+// Arrays are decoded as joined errors, objects with the "fields" key as
+// [GenericFields] (wrapped in [GenericError] when they have the "code" key)
+// and all other objects as [GenericError] (see [decodeNode]).
+func decodeCause[T Domain](data json.RawMessage) (error, error) {
+	if len(data) > 0 && data[0] == '[' {
+		var raw []json.RawMessage
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+		ers := make([]error, 0, len(raw))
+		for _, entry := range raw {
+			err, dErr := decodeCause[T](entry)
+			if dErr != nil {
+				return nil, dErr
+			}
+			ers = append(ers, err)
+		}
+		return errors.Join(ers...), nil
+	}
+
+	m := make(map[string]json.RawMessage, 4)
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	var cause error
+	if raw, ok := m["fields"]; ok {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+		fs := make(map[string]error, len(fields))
+		for field, entry := range fields {
+			err, dErr := decodeCause[T](entry)
+			if dErr != nil {
+				return nil, dErr
+			}
+			fs[field] = err
+		}
+		if _, ok = m["code"]; !ok {
+			return &GenericFields[T]{fields: fs}, nil
+		}
+		cause = &GenericFields[T]{fields: fs}
+	} else if raw, ok = m["cause"]; ok {
+		var err error
+		if cause, err = decodeCause[T](raw); err != nil {
+			return nil, err
+		}
+	}
+
+	return decodeNode[T](m, cause)
+}
+
+// decodeError decodes the JSON object representation of an error and its
+// causes (see [WithJSONCauses]). See [decodeNode] for details.
+func decodeError[T Domain](data []byte) (DomainError, error) {
+	m := make(map[string]json.RawMessage, 4)
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	var cause error
+	if raw, ok := m["cause"]; ok {
+		var err error
+		if cause, err = decodeCause[T](raw); err != nil {
+			return nil, err
+		}
+	}
+	return decodeNode[T](m, cause)
+}
+
+// Format implements [fmt.Formatter] for [GenericError]. The %+v verb prints
+// the message, the error code and the stack frames returned by [GetStack] if
+// any were recorded. The %#v verb prints the error tree rendered by [Tree].
 func (e *GenericError[T]) Format(state fmt.State, verb rune) {
+	if verb == 'v' && state.Flag('#') {
+		_, _ = fmt.Fprint(state, Tree(e))
+		return
+	}
 	Format(e.Error(), e.ErrorCode(), state, verb)
+	if verb == 'v' && state.Flag('+') {
+		if stack := GetStack(e); len(stack) > 0 {
+			_, _ = fmt.Fprint(state, "\n", stack)
+		}
+	}
 }
 
 // Format is a custom formatter for [GenericError] instances.
@@ -7,9 +7,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
 )
 
 func Test_ErrorFactory(t *testing.T) {
@@ -46,6 +49,162 @@ func Test_ErrorFactory(t *testing.T) {
 	})
 }
 
+func Test_TemplateFunc(t *testing.T) {
+	t.Run("without options", func(t *testing.T) {
+		// --- Given ---
+		have := TemplateFunc[EDXrr]()
+
+		// --- When ---
+		err := have("user {user_id} not found", "ECode")
+
+		// --- Then ---
+		e, _ := assert.SameType(t, &GenericError[EDXrr]{}, err)
+		assert.Equal(t, "user %!user_id(MISSING) not found", e.msg)
+		assert.Equal(t, "user {user_id} not found", e.tpl)
+		assert.Equal(t, "ECode", e.code)
+		assert.Nil(t, e.meta)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		have := TemplateFunc[EDXrr]()
+		cause := errors.New("cause")
+
+		// --- When ---
+		err := have(
+			"user {user_id} not found",
+			"ECode",
+			Meta().Str("user_id", "u-1").Option(),
+			WithCause(cause),
+		)
+
+		// --- Then ---
+		assert.Equal(t, "user u-1 not found: cause", err.Error())
+		assert.Equal(t, "user {user_id} not found", err.tpl)
+		assert.Equal(t, map[string]any{"user_id": "u-1"}, err.meta)
+		assert.Same(t, cause, err.err)
+	})
+
+	t.Run("stack recorded", func(t *testing.T) {
+		// --- When ---
+		e := TemplateFunc[string]()("msg", "ECode", WithStack())
+
+		// --- Then ---
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_TemplateFunc.func3"
+		assert.Equal(t, wFn, e.ErrorStack().Frames()[0].Function)
+	})
+}
+
+func Test_ErrorfFunc(t *testing.T) {
+	t.Run("without wrapped errors", func(t *testing.T) {
+		// --- Given ---
+		have := ErrorfFunc[EDXrr]()
+
+		// --- When ---
+		err := have("ECode", "user %s not found", "u-1")
+
+		// --- Then ---
+		e, _ := assert.SameType(t, &GenericError[EDXrr]{}, err)
+		assert.Equal(t, "user u-1 not found", e.msg)
+		assert.Equal(t, "ECode", e.code)
+		assert.Nil(t, e.meta)
+		assert.Nil(t, e.err)
+		assert.False(t, e.verbatim)
+	})
+
+	t.Run("single wrapped error", func(t *testing.T) {
+		// --- Given ---
+		have := ErrorfFunc[EDXrr]()
+		cause := New("cause", "ECCause")
+
+		// --- When ---
+		err := have("", "op %s: %w", "read", cause)
+
+		// --- Then ---
+		assert.Equal(t, "op read: cause", err.Error())
+		assert.Equal(t, "ECCause", err.ErrorCode())
+		assert.Same(t, cause, err.err)
+		assert.True(t, err.verbatim)
+		assert.ErrorIs(t, cause, err)
+	})
+
+	t.Run("multiple wrapped errors", func(t *testing.T) {
+		// --- Given ---
+		have := ErrorfFunc[EDXrr]()
+		cause0 := New("cause 0", "ECCause0")
+		cause1 := errors.New("cause 1")
+
+		// --- When ---
+		err := have("ECode", "op failed: %w, %w", cause0, cause1)
+
+		// --- Then ---
+		assert.Equal(t, "op failed: cause 0, cause 1", err.Error())
+		assert.Equal(t, "ECode", err.ErrorCode())
+		assert.Equal(t, []error{cause0, cause1}, Split(err.err))
+		assert.ErrorIs(t, cause0, err)
+		assert.ErrorIs(t, cause1, err)
+		assert.Equal(t, []string{"ECode", "ECCause0", ECGeneric}, GetCodes(err))
+	})
+
+	t.Run("named arguments", func(t *testing.T) {
+		// --- Given ---
+		have := ErrorfFunc[EDXrr]()
+
+		// --- When ---
+		err := have(
+			"ECode",
+			"user %s has %d items %v",
+			Arg("user_id", "u-1"),
+			Arg("count", 3),
+			Arg("unsupported", struct{}{}),
+		)
+
+		// --- Then ---
+		assert.Equal(t, "user u-1 has 3 items {}", err.Error())
+		want := map[string]any{"user_id": "u-1", "count": 3}
+		assert.Equal(t, want, err.meta)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		// --- Given ---
+		have := ErrorfFunc[EDXrr]()
+		cause := New("cause", "ECCause")
+
+		// --- When ---
+		err := have(
+			"",
+			"user %s: %w",
+			Arg("user_id", "u-1"),
+			Meta().Str("key", "val").Option(),
+			cause,
+			WithCode("ECode"),
+		)
+
+		// --- Then ---
+		assert.Equal(t, "user u-1: cause", err.Error())
+		assert.Equal(t, "ECode", err.code)
+		want := map[string]any{"user_id": "u-1", "key": "val"}
+		assert.Equal(t, want, err.meta)
+	})
+
+	t.Run("stack recorded", func(t *testing.T) {
+		// --- When ---
+		e := ErrorfFunc[string]()("ECode", "msg", WithStack())
+
+		// --- Then ---
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_ErrorfFunc.func6"
+		assert.Equal(t, wFn, e.ErrorStack().Frames()[0].Function)
+	})
+}
+
+func Test_Arg(t *testing.T) {
+	// --- When ---
+	have := Arg("name", 42)
+
+	// --- Then ---
+	assert.Equal(t, NamedArg{name: "name", value: 42}, have)
+}
+
 func Test_GenericError_Error(t *testing.T) {
 	t.Run("xrr error", func(t *testing.T) {
 		// --- Given ---
@@ -135,6 +294,21 @@ func Test_GenericError_Error(t *testing.T) {
 		// --- Then ---
 		assert.Equal(t, "msg: cause", have)
 	})
+
+	t.Run("verbatim message", func(t *testing.T) {
+		// --- Given ---
+		e := &GenericError[EDXrr]{
+			msg:      "custom",
+			err:      errors.New("cause"),
+			verbatim: true,
+		}
+
+		// --- When ---
+		have := e.Error()
+
+		// --- Then ---
+		assert.Equal(t, "custom", have)
+	})
 }
 
 func Test_GenericError_ErrorCode(t *testing.T) {
@@ -174,6 +348,101 @@ func Test_GenericError_MetaAll(t *testing.T) {
 		assert.NotSame(t, m, have)
 		assert.Equal(t, m, have)
 	})
+
+	t.Run("returns a deep copy of slices and groups", func(t *testing.T) {
+		// --- Given ---
+		e := &GenericError[string]{meta: map[string]any{
+			"A": []string{"a"},
+			"B": map[string]any{"C": []int{1}},
+		}}
+
+		// --- When ---
+		have := e.MetaAll()
+
+		// --- Then ---
+		have["A"].([]string)[0] = "x"
+		have["B"].(map[string]any)["C"].([]int)[0] = 2
+		have["B"].(map[string]any)["D"] = 3
+		want := map[string]any{
+			"A": []string{"a"},
+			"B": map[string]any{"C": []int{1}},
+		}
+		assert.Equal(t, want, e.meta)
+	})
+}
+
+func Test_GenericError_ErrorStack(t *testing.T) {
+	t.Run("stack not recorded", func(t *testing.T) {
+		// --- Given ---
+		e := &GenericError[string]{}
+
+		// --- When ---
+		have := e.ErrorStack()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("stack recorded", func(t *testing.T) {
+		// --- Given ---
+		e := ErrorFunc[string]()("msg", "ECode", WithStack())
+
+		// --- When ---
+		have := e.ErrorStack()
+
+		// --- Then ---
+		assert.Same(t, e.stack, have)
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_GenericError_ErrorStack.func2"
+		assert.Equal(t, wFn, have.Frames()[0].Function)
+	})
+}
+
+func Test_GenericError_ErrorTemplate(t *testing.T) {
+	t.Run("template", func(t *testing.T) {
+		// --- Given ---
+		e := &GenericError[EDXrr]{msg: "msg", tpl: "{key}"}
+
+		// --- When ---
+		have := e.ErrorTemplate()
+
+		// --- Then ---
+		assert.Equal(t, "{key}", have)
+	})
+
+	t.Run("no template", func(t *testing.T) {
+		// --- Given ---
+		e := &GenericError[EDXrr]{msg: "msg"}
+
+		// --- When ---
+		have := e.ErrorTemplate()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+}
+
+func Test_GenericError_ErrorDomain(t *testing.T) {
+	t.Run("marker type name", func(t *testing.T) {
+		// --- Given ---
+		e := &GenericError[EDXrr]{}
+
+		// --- When ---
+		have := e.ErrorDomain()
+
+		// --- Then ---
+		assert.Equal(t, "xrr.EDXrr", have)
+	})
+
+	t.Run("named domain", func(t *testing.T) {
+		// --- Given ---
+		e := &GenericError[edNamed]{}
+
+		// --- When ---
+		have := e.ErrorDomain()
+
+		// --- Then ---
+		assert.Equal(t, "named", have)
+	})
 }
 
 func Test_GenericError_Unwrap(t *testing.T) {
@@ -275,6 +544,132 @@ func Test_GenericError_MarshalJSON(t *testing.T) {
 	})
 }
 
+func Test_GenericError_MarshalJSON_domain(t *testing.T) {
+	t.Run("named domain", func(t *testing.T) {
+		// --- Given ---
+		e := &GenericError[edNamed]{msg: "msg", code: "ECode"}
+
+		// --- When ---
+		have, err := json.Marshal(e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{"error": "msg", "code": "ECode", "domain": "named"}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("domain key is ignored when decoding", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"error": "msg", "domain": "named"}`)
+		var e *GenericError[EDXrr]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, &GenericError[EDXrr]{msg: "msg", code: ECGeneric}, e)
+	})
+
+	t.Run("causes keep domains", func(t *testing.T) {
+		// --- Given ---
+		cause := &GenericError[edNamed]{msg: "cause", code: "ECCause"}
+		src := &GenericError[EDXrr]{msg: "msg", code: "ECode", err: cause}
+		data := must.Value(MarshalJSON(src, WithJSONCauses()))
+		var e *GenericError[EDXrr]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, cause, e.err)
+	})
+}
+
+func Test_GenericError_MarshalJSON_template(t *testing.T) {
+	t.Run("template", func(t *testing.T) {
+		// --- Given ---
+		e := NewTemplate("user {id}", "ECode", Meta().Int("id", 1).Option())
+
+		// --- When ---
+		have := must.Value(MarshalJSON(e, WithJSONTemplate()))
+
+		// --- Then ---
+		want := `{
+			"error": "user 1",
+			"template": "user {id}",
+			"code": "ECode",
+			"meta": {"id": 1}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("wrapped template", func(t *testing.T) {
+		// --- Given ---
+		e := Wrap(NewTemplate("user {id}", "ECode", Meta().Int("id", 1).Option()))
+
+		// --- When ---
+		have := must.Value(MarshalJSON(e, WithJSONTemplate()))
+
+		// --- Then ---
+		want := `{
+			"error": "user 1",
+			"template": "user {id}",
+			"code": "ECode",
+			"meta": {"id": 1}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("without option", func(t *testing.T) {
+		// --- Given ---
+		e := NewTemplate("user", "ECode")
+
+		// --- When ---
+		have := must.Value(json.Marshal(e))
+
+		// --- Then ---
+		assert.JSON(t, `{"error": "user", "code": "ECode"}`, string(have))
+	})
+
+	t.Run("causes", func(t *testing.T) {
+		// --- Given ---
+		e := New("op", "ECOp", WithCause(NewTemplate("user {id}", "ECode")))
+
+		// --- When ---
+		have := must.Value(MarshalJSON(e, WithJSONTemplate(), WithJSONCauses()))
+
+		// --- Then ---
+		want := `{
+			"error": "op: user %!id(MISSING)",
+			"code": "ECOp",
+			"cause": {
+				"error": "user %!id(MISSING)",
+				"template": "user {id}",
+				"code": "ECode"
+			}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		e := New("op", "ECOp", WithCause(NewTemplate("user {id}", "ECode")))
+		data := must.Value(MarshalJSON(e, WithJSONTemplate(), WithJSONCauses()))
+		var have *GenericError[EDXrr]
+
+		// --- When ---
+		err := json.Unmarshal(data, &have)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "op: user %!id(MISSING)", have.Error())
+		assert.Equal(t, "", have.ErrorTemplate())
+		assert.Equal(t, "user {id}", GetTemplate(have))
+	})
+}
+
 func Test_GenericError_UnmarshalJSON(t *testing.T) {
 	t.Run("without code and metadata", func(t *testing.T) {
 		// --- Given ---
@@ -330,6 +725,195 @@ func Test_GenericError_UnmarshalJSON(t *testing.T) {
 		assert.Equal(t, "2022-01-18T13:57:00Z", e.meta["tim"])
 	})
 
+	t.Run("with metadata types", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"error": "msg",
+			"code":  "ECode",
+			"meta": {
+				"num": 123,
+				"tim": "2022-01-18T13:57:00Z",
+				"dur": 1000,
+				"other": 1
+			},
+			"meta_types": {"num": "int", "tim": "time", "dur": "duration"}
+		}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{
+			"num":   123,
+			"tim":   time.Date(2022, 1, 18, 13, 57, 0, 0, time.UTC),
+			"dur":   time.Microsecond,
+			"other": 1.0,
+		}
+		assert.Equal(t, want, e.meta)
+	})
+
+	t.Run("error - metadata value does not match its type", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"error": "msg",
+			"meta": {"num": "abc"},
+			"meta_types": {"num": "int"}
+		}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+	})
+
+	t.Run("with cause", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"error": "msg: cause",
+			"code": "ECode",
+			"cause": {"error": "cause", "code": "ECCause"}
+		}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "msg", e.msg)
+		assert.Equal(t, "ECode", e.code)
+		assert.False(t, e.verbatim)
+		want := &GenericError[string]{msg: "cause", code: "ECCause"}
+		assert.Equal(t, want, e.err)
+	})
+
+	t.Run("with cause without own message", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"error": "cause",
+			"code": "ECode",
+			"cause": {"error": "cause", "code": "ECCause"}
+		}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "", e.msg)
+		assert.Equal(t, "cause", e.Error())
+	})
+
+	t.Run("with cause not matching the message", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"error": "custom",
+			"code": "ECode",
+			"cause": {"error": "cause", "code": "ECCause"}
+		}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "custom", e.msg)
+		assert.True(t, e.verbatim)
+		assert.Equal(t, "custom", e.Error())
+		assert.Equal(t, []string{"ECode", "ECCause"}, GetCodes(e))
+	})
+
+	t.Run("with joined causes", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"error": "msg: a; b",
+			"code": "ECode",
+			"cause": [
+				{"error": "a", "code": "ECA"},
+				{"error": "b", "code": "ECB"}
+			]
+		}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "msg", e.msg)
+		assert.True(t, IsJoined(e.err))
+		assert.Equal(t, []string{"ECode", "ECA", "ECB"}, GetCodes(e))
+	})
+
+	t.Run("with fields cause", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"error": "f: m",
+			"code": "ECode",
+			"cause": {
+				"error": "f: m",
+				"fields": {"f": {"error": "m", "code": "ECF"}}
+			}
+		}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := NewFields[string](map[string]error{
+			"f": &GenericError[string]{msg: "m", code: "ECF"},
+		})
+		assert.Equal(t, want, e.err)
+	})
+
+	t.Run("with coded fields cause", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"error": "f: m",
+			"code": "ECode",
+			"cause": {
+				"error": "f: m",
+				"code": "ECFields",
+				"fields": {"f": {"error": "m", "code": "ECF"}}
+			}
+		}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := &GenericError[string]{
+			code: "ECFields",
+			err: NewFields[string](map[string]error{
+				"f": &GenericError[string]{msg: "m", code: "ECF"},
+			}),
+		}
+		assert.Equal(t, want, e.err)
+	})
+
+	t.Run("error - invalid cause", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"error": "msg", "cause": {"code": "ECCause"}}`)
+		var e *GenericError[string]
+
+		// --- When ---
+		err := json.Unmarshal(data, &e)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvJSONError, err)
+	})
+
 	t.Run("error - without the error key", func(t *testing.T) {
 		// --- Given ---
 		data := []byte(`{"code":"code"}`)
@@ -394,6 +978,43 @@ func Test_GenericError_Format(t *testing.T) {
 		// --- Then ---
 		assert.Equal(t, "msg0\nmsg1", have)
 	})
+
+	t.Run("with stack", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode", WithStack())
+
+		// --- When ---
+		have := fmt.Sprintf("%+v", e)
+
+		// --- Then ---
+		lines := strings.Split(have, "\n")
+		assert.Equal(t, "msg (ECode)", lines[0])
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_GenericError_Format.func3"
+		assert.Equal(t, wFn, lines[1])
+		assert.True(t, strings.Contains(lines[2], "generic_error_test.go:"))
+	})
+
+	t.Run("stack is not printed without plus flag", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode", WithStack())
+
+		// --- When ---
+		have := fmt.Sprintf("%v", e)
+
+		// --- Then ---
+		assert.Equal(t, "msg", have)
+	})
+}
+
+func Test_GenericError_Format_tree(t *testing.T) {
+	// --- Given ---
+	e := New("m0", "EC0", WithCause(New("m1", "EC1")))
+
+	// --- When ---
+	have := fmt.Sprintf("%#v", e)
+
+	// --- Then ---
+	assert.Equal(t, "m0 (EC0) [xrr.EDXrr]\n  m1 (EC1) [xrr.EDXrr]", have)
 }
 
 func Test_GenericError_Format_tabular(t *testing.T) {
@@ -433,3 +1054,62 @@ func Test_GenericError_Format_tabular(t *testing.T) {
 }
 
 func Test_Format(t *testing.T) { /* See Test_GenericError_Format_tabular */ }
+
+func Test_decodeCause(t *testing.T) {
+	t.Run("error - invalid joined errors", func(t *testing.T) {
+		// --- When ---
+		have, err := decodeCause[EDXrr](json.RawMessage(`[1]`))
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid joined error", func(t *testing.T) {
+		// --- When ---
+		have, err := decodeCause[EDXrr](json.RawMessage(`[{"code": "EC"}]`))
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvJSONError, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid fields", func(t *testing.T) {
+		// --- Given ---
+		data := json.RawMessage(`{"error": "msg", "fields": [1]}`)
+
+		// --- When ---
+		have, err := decodeCause[EDXrr](data)
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid field error", func(t *testing.T) {
+		// --- Given ---
+		data := json.RawMessage(`{"error": "msg", "fields": {"f": {}}}`)
+
+		// --- When ---
+		have, err := decodeCause[EDXrr](data)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvJSONError, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid nested cause", func(t *testing.T) {
+		// --- Given ---
+		data := json.RawMessage(`{"error": "msg", "cause": {"cause": 1}}`)
+
+		// --- When ---
+		have, err := decodeCause[EDXrr](data)
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+}
@@ -15,14 +15,39 @@ import (
 var (
 	_ error            = (*GenericFields[EDXrr])(nil)
 	_ Fielder          = (*GenericFields[EDXrr])(nil)
+	_ orderedFielder   = (*GenericFields[EDXrr])(nil)
+	_ Domainer         = (*GenericFields[EDXrr])(nil)
 	_ json.Marshaler   = (*GenericFields[EDXrr])(nil)
 	_ json.Unmarshaler = (*GenericFields[EDXrr])(nil)
+
+	_ error          = fieldErrorList{}
+	_ joined         = fieldErrorList{}
+	_ jsonEncoder    = fieldErrorList{}
+	_ json.Marshaler = fieldErrorList{}
 )
 
 // GenericFields represents a generic type for creating domain-specific
 // field-indexed errors.
+//
+// By default, the fields are reported in the alphabetical order. Instances
+// created with [NewOrderedFields] remember the order in which the fields
+// were added and report the fields in that order.
 type GenericFields[T Domain] struct {
 	fields map[string]error
+
+	// Field names in the insertion order, nil when the fields are reported
+	// in the alphabetical order.
+	order []string
+}
+
+// orderedFielder is the interface implemented by [Fielder] errors which may
+// remember the insertion order of the fields.
+type orderedFielder interface {
+	Fielder
+
+	// fieldOrder returns the field names in the insertion order or nil if
+	// the fields are reported in the alphabetical order.
+	fieldOrder() []string
 }
 
 // NewFields creates a new [GenericFields][T] from the given map. The map
@@ -31,6 +56,16 @@ func NewFields[T Domain](fields map[string]error) *GenericFields[T] {
 	return &GenericFields[T]{fields: fields}
 }
 
+// NewOrderedFields creates a new empty [GenericFields][T] which remembers
+// the order in which the fields are added with [GenericFields.Set],
+// [GenericFields.SetPath] and [GenericFields.Merge]. The order is used by
+// Error, Unwrap, [FieldNames] and the JSON representation, and is
+// preserved by [GenericFields.Flatten], [GenericFields.Filter],
+// [MergeFields] and [Flatten].
+func NewOrderedFields[T Domain]() *GenericFields[T] {
+	return &GenericFields[T]{fields: make(map[string]error), order: []string{}}
+}
+
 // FieldsFunc returns a function for creating domain-specific field errors.
 func FieldsFunc[T Domain]() func(field string, err error) *GenericFields[T] {
 	return func(field string, err error) *GenericFields[T] {
@@ -50,9 +85,12 @@ func GetFields(err error) map[string]error {
 	return nil
 }
 
-// GetFieldError returns an error for the given field name. It expects the
-// error to implement [Fielder]. Returns nil when err is nil, does not
-// implement [Fielder], or has no error for the given field name.
+// GetFieldError returns an error for the given field name or path (see
+// [GenericFields.Get]). It expects the error to implement [Fielder]. Returns
+// nil when err is nil, does not implement [Fielder], or has no error for the
+// given field name. For fields with multiple errors (see
+// [GenericFields.AddField]), the returned error joins them, use
+// [SplitFieldErrors] to get them one by one.
 func GetFieldError(err error, field string) error {
 	if fs := GetFields(err); fs != nil {
 		return get(fs, field)
@@ -60,6 +98,18 @@ func GetFieldError(err error, field string) error {
 	return nil
 }
 
+// SplitFieldErrors returns the errors of a field with multiple errors (see
+// [GenericFields.AddField]) one by one, for example, the error returned by
+// [GetFieldError]. Unlike [Split], other errors, including the joined ones,
+// are returned as the only element of the slice. Returns nil when err is
+// nil.
+func SplitFieldErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	return fieldErrs(err).Unwrap()
+}
+
 // FieldErrorIs returns true if err implements [Fielder], has the given field
 // name, and [errors.Is] returns true for that field's error and target.
 func FieldErrorIs(err error, field string, target error) bool {
@@ -67,37 +117,66 @@ func FieldErrorIs(err error, field string, target error) bool {
 }
 
 // FieldNames returns alphabetically sorted field names if the error implements
-// [Fielder]. Otherwise, it returns nil.
+// [Fielder]. For [GenericFields] created with [NewOrderedFields], the names
+// are returned in the insertion order. Otherwise, it returns nil.
 func FieldNames(err error) []string {
 	fs := GetFields(err)
-	if fs == nil {
+	if len(fs) == 0 {
 		return nil
 	}
-	var names []string
-	for name := range fs {
-		names = append(names, name)
-	}
-	slices.Sort(names)
-	return names
+	return fieldNames(fs, orderOf(err.(Fielder)))
 }
 
 // MergeFields merges multiple [Fielder] errors into a single [GenericFields[T]].
-// Returns nil if all inputs are nil or the slice is empty.
+// Returns nil if all inputs are nil or the slice is empty. When any of the
+// inputs remembers the field order (see [NewOrderedFields]), the result
+// remembers the order in which the fields appear in the inputs.
 //
 // Merge rules:
 //   - nil errors are skipped.
 //   - For [Fielder] errors, all field entries are merged into the result.
 //   - For non-[Fielder] errors, a synthetic key "__field__N" is used, where N
 //     is the position of the error in the argument list.
-//   - When two inputs share a field name, the later one wins.
+//   - When two inputs share a field name, the later one wins. Use
+//     [GenericFields.AddField] to keep the errors of both.
 //   - nil field values are preserved (they are not treated as absent).
 func MergeFields[T Domain](ers ...error) error {
+	order := mergeOrder(ers...)
 	if fe := mergeFields(ers...); fe != nil {
-		return &GenericFields[T]{fields: fe}
+		ret := &GenericFields[T]{fields: fe}
+		if order != nil {
+			ret.order = fieldNames(fe, order)
+		}
+		return ret
 	}
 	return nil
 }
 
+// mergeOrder returns the order of the fields merged by [mergeFields] or nil
+// when none of the errors remembers the field order. It must be called
+// before [mergeFields] which modifies the first field errors map.
+func mergeOrder(ers ...error) []string {
+	var ordered bool
+	order := make([]string, 0, len(ers))
+	for i, err := range ers {
+		if err == nil {
+			continue
+		}
+		fe, ok := err.(Fielder)
+		if !ok {
+			order = append(order, fmt.Sprintf("__field__%d", i))
+			continue
+		}
+		fo := orderOf(fe)
+		ordered = ordered || fo != nil
+		order = append(order, fieldNames(fe.ErrorFields(), fo)...)
+	}
+	if !ordered {
+		return nil
+	}
+	return order
+}
+
 // mergeFields merges multiple field error maps into a single map. Non-[Fielder]
 // errors are assigned synthetic keys of the form "__field__N".
 func mergeFields(ers ...error) map[string]error {
@@ -154,21 +233,30 @@ func mergeFields(ers ...error) map[string]error {
 
 func (fs *GenericFields[T]) ErrorFields() map[string]error { return fs.fields }
 
+func (fs *GenericFields[T]) fieldOrder() []string { return fs.order }
+
+// ErrorDomain returns the name of the error domain. See [DomainNamer].
+func (fs *GenericFields[T]) ErrorDomain() string { return domainName[T]() }
+
 func (fs *GenericFields[T]) Error() string {
-	return formatFields(fs.ErrorFields(), false)
+	return formatFields(fs.ErrorFields(), fs.order, false)
 }
 
 func (fs *GenericFields[T]) Unwrap() []error {
-	flat := fs.Flatten()
-	fields, ers := sortFields(flat.fields)
-	var j int
-	for i, err := range ers {
-		if err != nil {
-			ers[j] = fmt.Errorf("%s: %w", fields[i], err)
-			j++
+	flat, names := flatFields(fs.fields, fs.order)
+	var ers []error
+	for _, name := range names {
+		if err := flat[name]; err != nil {
+			for _, e := range fieldErrs(err) {
+				if name == "" {
+					ers = append(ers, e)
+					continue
+				}
+				ers = append(ers, fmt.Errorf("%s: %w", name, e))
+			}
 		}
 	}
-	return ers[:j]
+	return ers
 }
 
 // Is implements the interface used by [errors.Is].
@@ -184,7 +272,14 @@ func (fs *GenericFields[T]) Is(other error) bool {
 	return false
 }
 
+// Format implements [fmt.Formatter] for [GenericFields]. The %+v verb prints
+// field errors with their codes and the %#v verb prints the error tree
+// rendered by [Tree].
 func (fs *GenericFields[T]) Format(state fmt.State, verb rune) {
+	if verb == 'v' && state.Flag('#') {
+		_, _ = fmt.Fprint(state, Tree(fs))
+		return
+	}
 	switch verb {
 	case 's', 'q':
 		msg := fs.Error()
@@ -195,7 +290,7 @@ func (fs *GenericFields[T]) Format(state fmt.State, verb rune) {
 
 	case 'v':
 		if state.Flag('+') {
-			_, _ = fmt.Fprint(state, formatFields(fs.ErrorFields(), true))
+			_, _ = fmt.Fprint(state, formatFields(fs.ErrorFields(), fs.order, true))
 		} else {
 			msg := fs.Error()
 			_, _ = fmt.Fprint(state, msg)
@@ -226,9 +321,34 @@ func (fs *GenericFields[T]) Format(state fmt.State, verb rune) {
 //
 //	err := fields.Flatten().Filter() // nil when all fields are nil/absent
 func (fs *GenericFields[T]) Flatten() *GenericFields[T] {
-	visitor := make(map[string]error, len(fs.fields))
-	flatten(visitor, "", fs.fields)
-	return &GenericFields[T]{fields: visitor}
+	flat, names := flatFields(fs.fields, fs.order)
+	ret := &GenericFields[T]{fields: flat}
+	if fs.order != nil {
+		ret.order = names
+	}
+	return ret
+}
+
+// Unflatten is the inverse of [GenericFields.Flatten]. It returns the nested
+// field errors rebuilt from the keys with dots and indexes in square
+// brackets (see [ParseFieldPath]):
+//
+//	map[string]error{
+//	  "a": &GenericFields[T]{
+//	    fields: map[string]error{"b": errors.New("b")},
+//	  },
+//	}
+//
+// The field errors already nested are flattened first. The error of the
+// field which also has nested field errors is moved to the empty key of
+// the nested instance (see [GenericFields.SetPath]). Field names containing
+// dots or square brackets are split into path segments.
+func (fs *GenericFields[T]) Unflatten() *GenericFields[T] {
+	flat, names := flatFields(fs.fields, fs.order)
+	if fs.order == nil {
+		names = nil
+	}
+	return unflatten[T](flat, names)
 }
 
 // Filter removes all keys with nil values from Fields and returns it as an
@@ -237,22 +357,24 @@ func (fs *GenericFields[T]) Filter() error {
 	if fs == nil {
 		return nil
 	}
-	if ret := filterMap[T](fs.fields); ret != nil {
+	if ret := filterMap[T](fs.fields, fs.order); ret != nil {
 		return ret
 	}
 	return nil
 }
 
 // filterMap returns a new map with nil values removed. Nested [Fielder] values
-// are filtered recursively. Returns nil if no entries survive filtering.
-func filterMap[T Domain](fs map[string]error) *GenericFields[T] {
+// are filtered recursively. Returns nil if no entries survive filtering. The
+// returned instance remembers the field order when the order is not nil.
+func filterMap[T Domain](fs map[string]error, order []string) *GenericFields[T] {
 	ret := make(map[string]error, len(fs))
 	for key, value := range fs {
 		if value == nil {
 			continue
 		}
 		if fls, ok := value.(Fielder); ok {
-			if filtered := filterMap[T](fls.ErrorFields()); filtered != nil {
+			filtered := filterMap[T](fls.ErrorFields(), orderOf(fls))
+			if filtered != nil {
 				ret[key] = filtered
 			}
 			continue
@@ -262,37 +384,42 @@ func filterMap[T Domain](fs map[string]error) *GenericFields[T] {
 	if len(ret) == 0 {
 		return nil
 	}
+	if order != nil {
+		return &GenericFields[T]{fields: ret, order: fieldNames(ret, order)}
+	}
 	return &GenericFields[T]{fields: ret}
 }
 
 // Merge adds errors from errs for keys that are not already set in fs.
-// It is a no-op when fs is nil or errs is empty.
+// It is a no-op when fs is nil or errs is empty. When fs remembers the field
+// order, the new keys are added in the alphabetical order.
 func (fs *GenericFields[T]) Merge(errs map[string]error) {
 	if fs == nil || len(errs) == 0 {
 		return
 	}
-	if fs.fields == nil {
-		fs.fields = make(map[string]error, len(errs))
-	}
-	for key, err := range errs {
+	for _, key := range fieldNames(errs, nil) {
 		if fs.fields[key] == nil {
-			fs.fields[key] = err
+			fs.Set(key, errs[key])
 		}
 	}
 }
 
 // Get returns an error for the given field, nil if the field does not exist.
+// The field may be a path to the nested field error, for example,
+// "address.city" or "items[3].price". For fields with multiple errors (see
+// [GenericFields.AddField]), the returned error joins them.
 func (fs *GenericFields[T]) Get(field string) error {
 	return get(fs.fields, field)
 }
 
 // get returns an error for the given field, nil if the field does not exist.
+// The field may be a path to the nested field error (see [FieldPath]).
 func get(ers map[string]error, field string) error {
 	for key, err := range ers {
 		if field == key {
 			return err
 		}
-		suffix, ok := strings.CutPrefix(field, key+".")
+		suffix, ok := cutPrefix(field, key)
 		if !ok {
 			continue
 		}
@@ -313,9 +440,107 @@ func (fs *GenericFields[T]) Set(field string, err error) {
 	if fs.fields == nil {
 		fs.fields = make(map[string]error)
 	}
+	if _, ok := fs.fields[field]; !ok && fs.order != nil {
+		fs.order = append(fs.order, field)
+	}
 	fs.fields[field] = err
 }
 
+// SetPath sets the error for the field at the path creating the nested
+// [GenericFields] for the path segments as needed. Field errors which are
+// not [GenericFields] of the same domain, found on the way, are moved to the
+// empty key of the created nested instance, so they are still reported for
+// the same field. The empty path is a no-op. It is a no-op when fs is nil.
+//
+//	fs.SetPath(xrr.Path().Field("items").Index(3).Field("price"), err)
+//	fs.Get("items[3].price") // err
+func (fs *GenericFields[T]) SetPath(pth FieldPath, err error) {
+	if fs == nil || pth.IsZero() {
+		return
+	}
+	cur, last := fs.pathParent(pth)
+	if nested, ok := cur.fields[last].(*GenericFields[T]); ok {
+		nested.Set("", err)
+		return
+	}
+	cur.Set(last, err)
+}
+
+// AddField adds the error for the given field. Unlike [GenericFields.Set],
+// it keeps the errors already added for the field, so the field reports all
+// of them in the order they were added:
+//
+//	fs.AddField("password", ErrTooShort)
+//	fs.AddField("password", ErrNoDigit)
+//	fs.Error() // password: too short; password: no digit
+//
+// When the field has nested field errors, the error is added to the empty
+// key of the nested instance (see [GenericFields.SetPath]). When err is the
+// [GenericFields] of the same domain, the errors already added for the field
+// are moved to its empty key, or, when the field has nested field errors
+// too, the fields of err are added to them. The nil errors are ignored. It
+// is a no-op when fs is nil.
+func (fs *GenericFields[T]) AddField(field string, err error) {
+	if fs == nil || err == nil {
+		return
+	}
+	prev := fs.fields[field]
+	nested, _ := prev.(*GenericFields[T]) // nolint: errorlint
+	sub, _ := err.(*GenericFields[T])     // nolint: errorlint
+	switch {
+	case nested != nil && sub != nil:
+		for _, key := range fieldNames(sub.fields, sub.order) {
+			nested.AddField(key, sub.fields[key])
+		}
+
+	case nested != nil:
+		nested.AddField("", err)
+
+	case sub != nil && prev != nil:
+		if cur := sub.fields[""]; cur != nil {
+			prev = addFieldError(prev, cur)
+		}
+		sub.Set("", prev)
+		fs.Set(field, sub)
+
+	default:
+		fs.Set(field, addFieldError(prev, err))
+	}
+}
+
+// AddPath works like [GenericFields.SetPath] but adds the error for the
+// field at the path the same way as [GenericFields.AddField].
+func (fs *GenericFields[T]) AddPath(pth FieldPath, err error) {
+	if fs == nil || pth.IsZero() || err == nil {
+		return
+	}
+	cur, last := fs.pathParent(pth)
+	cur.AddField(last, err)
+}
+
+// pathParent returns the nested [GenericFields] for the path without its last
+// segment, creating them as needed (see [GenericFields.SetPath]), and the
+// last segment of the path. The path must not be empty.
+func (fs *GenericFields[T]) pathParent(pth FieldPath) (*GenericFields[T], string) {
+	cur := fs
+	segs := pth.segs
+	for _, seg := range segs[:len(segs)-1] {
+		next, ok := cur.fields[seg].(*GenericFields[T])
+		if !ok {
+			next = &GenericFields[T]{}
+			if cur.order != nil {
+				next.order = []string{}
+			}
+			if prev := cur.fields[seg]; prev != nil {
+				next.Set("", prev)
+			}
+			cur.Set(seg, next)
+		}
+		cur = next
+	}
+	return cur, segs[len(segs)-1]
+}
+
 // Len returns the number of fields. Returns 0 if fs is nil.
 func (fs *GenericFields[T]) Len() int {
 	if fs == nil {
@@ -325,51 +550,55 @@ func (fs *GenericFields[T]) Len() int {
 }
 
 func (fs *GenericFields[T]) MarshalJSON() ([]byte, error) {
-	visitor := make(map[string]error, len(fs.fields))
-	flatten(visitor, "", fs.fields)
-	ret := make(map[string]json.RawMessage, len(visitor))
-	fls := filterMap[T](visitor)
-	if fls == nil {
+	return fs.encodeJSON(JSONOptions{})
+}
+
+// encodeJSON returns JSON representation of the field errors configured with
+// ops.
+func (fs *GenericFields[T]) encodeJSON(ops JSONOptions) ([]byte, error) {
+	flat, names := flatFields(fs.fields, fs.order)
+	names = slices.DeleteFunc(names, func(name string) bool {
+		return flat[name] == nil
+	})
+	if len(names) == 0 {
+		if ops.fieldStyle == FieldStyleList {
+			return []byte(`[]`), nil
+		}
 		return []byte(`{}`), nil
 	}
-	for k, v := range fls.fields {
-		if e, ok := v.(json.Marshaler); ok { // nolint: errorlint
-			data, err := e.MarshalJSON()
-			if err != nil {
-				return nil, err
-			}
-			ret[k] = data
-			continue
-		}
-		m := errorAsMap(v)
-		data, err := json.Marshal(m)
-		if err != nil {
-			return nil, err
-		}
-		ret[k] = data
+	return encodeFields(names, flat, fs.order != nil, ops)
+}
+
+// marshalField returns JSON representation of the field error configured
+// with ops.
+func marshalField(err error, ops JSONOptions) ([]byte, error) {
+	switch e := err.(type) { // nolint: errorlint
+	case jsonEncoder:
+		return e.encodeJSON(ops)
+	case json.Marshaler:
+		return e.MarshalJSON()
 	}
-	return json.Marshal(ret)
+	return json.Marshal(errorAsMap(err, ops))
 }
 
+// UnmarshalJSON unmarshals JSON representation of the [GenericFields] in
+// any of the [FieldStyle] styles. The field errors are decoded as
+// [GenericError] instances, and the nesting is rebuilt from the field paths
+// (see [GenericFields.Unflatten]), so [GenericFields.Get], [FieldNames] and
+// the JSON encoding report the same fields as for the original error.
 func (fs *GenericFields[T]) UnmarshalJSON(data []byte) error {
-	var raw map[string]json.RawMessage
-	if err := json.Unmarshal(data, &raw); err != nil {
+	fields, err := decodeFields[T](data)
+	if err != nil {
 		return err
 	}
-	fs.fields = make(map[string]error, len(raw))
-	for k, v := range raw {
-		var e GenericError[T]
-		if err := json.Unmarshal(v, &e); err != nil {
-			return err
-		}
-		fs.fields[k] = &e
-	}
+	fs.fields = unflatten[T](fields, nil).fields
 	return nil
 }
 
 // Flatten first merges all the provided errors, then it flattens a nested map
 // of errors to single one level map. The fields for nested errors are prefixed
-// with the field name of the parent separated by dots (.).
+// with the field name of the parent separated by dots (.). The field order
+// is preserved as in [MergeFields].
 //
 // Flatten example:
 //
@@ -378,10 +607,67 @@ func (fs *GenericFields[T]) UnmarshalJSON(data []byte) error {
 //	  "a.b": errors.New("b"),
 //	}
 func Flatten[T Domain](err ...error) error {
-	visitor := make(map[string]error)
+	order := mergeOrder(err...)
 	fls := mergeFields(err...)
-	flatten(visitor, "", fls)
-	return &GenericFields[T]{fields: visitor}
+	if order == nil {
+		visitor := make(map[string]error)
+		flatten(visitor, "", fls)
+		return &GenericFields[T]{fields: visitor}
+	}
+	flat, names := flatFields(fls, fieldNames(fls, order))
+	return &GenericFields[T]{fields: flat, order: names}
+}
+
+// Unflatten first merges all the provided errors, then it rebuilds the
+// nested field errors from the keys with dots and indexes in square
+// brackets. See [GenericFields.Unflatten] for details.
+//
+// Unflatten example:
+//
+//	map[string]error{
+//	  "a.b": errors.New("b"),
+//	  "c[0]": errors.New("c"),
+//	}
+//
+// becomes
+//
+//	map[string]error{
+//	  "a": &GenericFields[T]{
+//	    fields: map[string]error{"b": errors.New("b")},
+//	  },
+//	  "c": &GenericFields[T]{
+//	    fields: map[string]error{"[0]": errors.New("c")},
+//	  },
+//	}
+func Unflatten[T Domain](err ...error) error {
+	order := mergeOrder(err...)
+	fls := mergeFields(err...)
+	if order != nil {
+		order = fieldNames(fls, order)
+	}
+	flat, names := flatFields(fls, order)
+	if order == nil {
+		names = nil
+	}
+	return unflatten[T](flat, names)
+}
+
+// unflatten returns the nested field errors rebuilt from the flat map. The
+// returned instance remembers the field order when the order is not nil.
+func unflatten[T Domain](fields map[string]error, order []string) *GenericFields[T] {
+	ret := &GenericFields[T]{fields: make(map[string]error, len(fields))}
+	if order != nil {
+		ret.order = []string{}
+	}
+	for _, field := range fieldNames(fields, order) {
+		err := fields[field]
+		if pth := ParseFieldPath(field); !pth.IsZero() {
+			ret.SetPath(pth, err)
+			continue
+		}
+		ret.Set(field, err)
+	}
+	return ret
 }
 
 // flatten flattens nested map of errors.
@@ -395,22 +681,86 @@ func flatten(visitor map[string]error, pref string, fields map[string]error) {
 	}
 }
 
-// formatFields returns string representation of Fields.
-func formatFields(fs map[string]error, codes bool) string {
-	if len(fs) == 0 {
-		return ""
+// flatFields returns the flattened map of field errors (see [flatten]) and
+// the flattened field names in the field order. The names are sorted
+// alphabetically when the order is nil. The order of nested field errors
+// is used for their fields when the order is not nil.
+func flatFields(fields map[string]error, order []string) (map[string]error, []string) {
+	visitor := make(map[string]error, len(fields))
+	names := flattenOrdered(visitor, make([]string, 0, len(fields)), "", fields, order)
+	if order == nil {
+		slices.Sort(names)
+	}
+	return visitor, names
+}
+
+// flattenOrdered works like [flatten] but visits the fields in the field
+// order (see [fieldNames]) and returns names with the flattened field names
+// appended in the visiting order.
+func flattenOrdered(
+	visitor map[string]error,
+	names []string,
+	pref string,
+	fields map[string]error,
+	order []string,
+) []string {
+	for _, field := range fieldNames(fields, order) {
+		err := fields[field]
+		if fls, ok := err.(Fielder); ok {
+			sub, fo := fls.ErrorFields(), orderOf(fls)
+			names = flattenOrdered(visitor, names, prefix(pref, field), sub, fo)
+			continue
+		}
+		key := prefix(pref, field)
+		if _, ok := visitor[key]; !ok {
+			names = append(names, key)
+		}
+		visitor[key] = err
+	}
+	return names
+}
+
+// fieldNames returns the names of the fields in the order. The names from
+// the order which are in the fields map come first, followed by the
+// remaining names sorted alphabetically. With the nil order, all the names
+// are sorted alphabetically.
+func fieldNames(fields map[string]error, order []string) []string {
+	names := make([]string, 0, len(fields))
+	seen := make(map[string]bool, len(order))
+	for _, name := range order {
+		if _, ok := fields[name]; ok && !seen[name] {
+			names = append(names, name)
+			seen[name] = true
+		}
+	}
+	rest := len(names)
+	for name := range fields {
+		if !seen[name] {
+			names = append(names, name)
+		}
 	}
+	slices.Sort(names[rest:])
+	return names
+}
 
-	visitor := make(map[string]error, len(fs))
-	flatten(visitor, "", fs)
+// orderOf returns the field order of the [Fielder] error or nil when it does
+// not remember the field order.
+func orderOf(fe Fielder) []string {
+	if of, ok := fe.(orderedFielder); ok {
+		return of.fieldOrder()
+	}
+	return nil
+}
 
-	keys := make([]string, len(visitor))
-	i := 0
-	for key := range visitor {
-		keys[i] = key
-		i++
+// formatFields returns string representation of Fields. The fields are
+// formatted in the order (see [flatFields]). Errors for the empty field name
+// are formatted without the field name prefix.
+func formatFields(fs map[string]error, order []string, codes bool) string {
+	if len(fs) == 0 {
+		return ""
 	}
-	slices.Sort(keys)
+
+	visitor, keys := flatFields(fs, order)
 
 	var s strings.Builder
 	for _, key := range keys {
@@ -421,22 +771,64 @@ func formatFields(fs map[string]error, codes bool) string {
 		if s.Len() > 0 {
 			s.WriteString("; ")
 		}
-		if codes {
-			_, _ = fmt.Fprintf(
-				&s,
-				"%v: %v (%s)",
-				key,
-				err.Error(),
-				GetCode(err),
-			)
-		} else {
-			_, _ = fmt.Fprintf(
-				&s,
-				"%v: %v",
-				key,
-				errorMessage(err),
-			)
+		for i, e := range fieldErrs(err) {
+			if i > 0 {
+				s.WriteString("; ")
+			}
+			if key != "" {
+				s.WriteString(key + ": ")
+			}
+			if codes {
+				_, _ = fmt.Fprintf(&s, "%v (%s)", e.Error(), GetCode(e))
+			} else {
+				s.WriteString(errorMessage(e))
+			}
 		}
 	}
 	return s.String()
 }
+
+// fieldErrorList represents multiple errors for a single field added with
+// [GenericFields.AddField]. It is never empty.
+type fieldErrorList []error
+
+func (fe fieldErrorList) Error() string { return errorMessage(fe) }
+
+func (fe fieldErrorList) Unwrap() []error { return slices.Clone(fe) }
+
+func (fe fieldErrorList) MarshalJSON() ([]byte, error) {
+	return fe.encodeJSON(JSONOptions{})
+}
+
+// encodeJSON returns JSON array with the representations of the field errors
+// configured with ops.
+func (fe fieldErrorList) encodeJSON(ops JSONOptions) ([]byte, error) {
+	ret := make([]json.RawMessage, 0, len(fe))
+	for _, err := range fe {
+		data, e := marshalField(err, ops)
+		if e != nil {
+			return nil, e
+		}
+		ret = append(ret, data)
+	}
+	return json.Marshal(ret)
+}
+
+// addFieldError returns the error of the field with err added to the errors
+// already set for the field. Returns err when prev is nil.
+func addFieldError(prev, err error) error {
+	if prev == nil {
+		return err
+	}
+	ers := slices.Clip(fieldErrs(prev))
+	return append(ers, fieldErrs(err)...)
+}
+
+// fieldErrs returns the errors of the field. The slice with the single error
+// is returned for the fields with one error.
+func fieldErrs(err error) fieldErrorList {
+	if fe, ok := err.(fieldErrorList); ok { // nolint: errorlint
+		return fe
+	}
+	return fieldErrorList{err}
+}
@@ -39,6 +39,17 @@ func Test_NewFields(t *testing.T) {
 	})
 }
 
+func Test_NewOrderedFields(t *testing.T) {
+	// --- When ---
+	have := NewOrderedFields[EDXrr]()
+
+	// --- Then ---
+	assert.NotNil(t, have.fields)
+	assert.Len(t, 0, have.fields)
+	assert.NotNil(t, have.order)
+	assert.Len(t, 0, have.order)
+}
+
 func Test_FieldsFactory(t *testing.T) {
 	t.Run("create error", func(t *testing.T) {
 		// --- Given ---
@@ -132,6 +143,64 @@ func Test_GetFieldError(t *testing.T) {
 	})
 }
 
+func Test_SplitFieldErrors(t *testing.T) {
+	t.Run("multiple errors", func(t *testing.T) {
+		// --- Given ---
+		e0, e1 := errors.New("em0"), errors.New("em1")
+		fs := &GenericFields[EDXrr]{}
+		fs.AddField("f0", e0)
+		fs.AddField("f0", e1)
+
+		// --- When ---
+		have := SplitFieldErrors(fs.Get("f0"))
+
+		// --- Then ---
+		assert.Equal(t, []error{e0, e1}, have)
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		// --- When ---
+		have := SplitFieldErrors(ErrTst)
+
+		// --- Then ---
+		assert.Equal(t, []error{ErrTst}, have)
+	})
+
+	t.Run("joined error is not split", func(t *testing.T) {
+		// --- Given ---
+		err := errors.Join(errors.New("em0"), errors.New("em1"))
+
+		// --- When ---
+		have := SplitFieldErrors(err)
+
+		// --- Then ---
+		assert.Len(t, 1, have)
+		assert.Same(t, err, have[0])
+	})
+
+	t.Run("returns a copy", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+		fs.AddField("f0", errors.New("em0"))
+		fs.AddField("f0", errors.New("em1"))
+		have := SplitFieldErrors(fs.Get("f0"))
+
+		// --- When ---
+		have[0] = ErrTst
+
+		// --- Then ---
+		assert.Equal(t, "f0: em0; f0: em1", fs.Error())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := SplitFieldErrors(nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
 func Test_FieldErrorIs(t *testing.T) {
 	t.Run("nil error", func(t *testing.T) {
 		// --- When ---
@@ -444,6 +513,17 @@ func Test_GenericFields_ErrorFields(t *testing.T) {
 	assert.Equal(t, fields, have)
 }
 
+func Test_GenericFields_ErrorDomain(t *testing.T) {
+	// --- Given ---
+	fs := &GenericFields[edNamed]{}
+
+	// --- When ---
+	have := fs.ErrorDomain()
+
+	// --- Then ---
+	assert.Equal(t, "named", have)
+}
+
 func Test_GenericFields_Error(t *testing.T) {
 	t.Run("not nested", func(t *testing.T) {
 		// --- Given ---
@@ -499,6 +579,23 @@ func Test_GenericFields_Error(t *testing.T) {
 		assert.Equal(t, want, have)
 	})
 
+	t.Run("empty field name", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"":   errors.New("em"),
+				"f0": errors.New("em0"),
+			},
+		}
+
+		// --- When ---
+		have := fs.Error()
+
+		// --- Then ---
+		assert.Equal(t, "em; f0: em0", have)
+		assert.Equal(t, "em (ECGeneric); f0: em0 (ECGeneric)", fmt.Sprintf("%+v", fs))
+	})
+
 	t.Run("nil error", func(t *testing.T) {
 		// --- Given ---
 		fs := &GenericFields[EDXrr]{
@@ -862,6 +959,24 @@ func Test_GenericFields_Format(t *testing.T) {
 			"f2: em2 (ECode2)"
 		assert.Equal(t, want, have)
 	})
+	t.Run("tree", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0": NewFieldError("s0", New("em00", "ECode00")),
+				"f1": New("em1", "ECode1", Meta().Str("key", "val").Option()),
+			},
+		}
+
+		// --- When ---
+		have := fmt.Sprintf("%#v", fs)
+
+		// --- Then ---
+		want := "" +
+			"f0.s0: em00 (ECode00) [xrr.EDXrr]\n" +
+			"f1: em1 (ECode1) [xrr.EDXrr] {key: \"val\"}"
+		assert.Equal(t, want, have)
+	})
 }
 
 func Test_GenericFields_Flatten(t *testing.T) {
@@ -902,6 +1017,98 @@ func Test_GenericFields_Flatten(t *testing.T) {
 	})
 }
 
+func Test_GenericFields_Unflatten(t *testing.T) {
+	t.Run("unflatten", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0.s0":       errors.New("em00"),
+				"f0.s1[1]":    errors.New("em011"),
+				"f0.s1[0].s0": errors.New("em0100"),
+				"f1":          New("em1", "ECode1"),
+			},
+		}
+
+		// --- When ---
+		have := fs.Unflatten()
+
+		// --- Then ---
+		want := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0": &GenericFields[EDXrr]{
+					fields: map[string]error{
+						"s0": errors.New("em00"),
+						"s1": &GenericFields[EDXrr]{
+							fields: map[string]error{
+								"[0]": &GenericFields[EDXrr]{
+									fields: map[string]error{
+										"s0": errors.New("em0100"),
+									},
+								},
+								"[1]": errors.New("em011"),
+							},
+						},
+					},
+				},
+				"f1": New("em1", "ECode1"),
+			},
+		}
+		assert.Equal(t, want, have)
+		assert.Equal(t, fs.Error(), have.Error())
+	})
+
+	t.Run("already nested", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0": &GenericFields[EDXrr]{
+					fields: map[string]error{"s0.t0": errors.New("em000")},
+				},
+			},
+		}
+
+		// --- When ---
+		have := fs.Unflatten()
+
+		// --- Then ---
+		f0, _ := assert.SameType(t, &GenericFields[EDXrr]{}, have.fields["f0"])
+		s0, _ := assert.SameType(t, &GenericFields[EDXrr]{}, f0.fields["s0"])
+		assert.ErrorEqual(t, "em000", s0.fields["t0"])
+	})
+
+	t.Run("field with nested field errors", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0":    errors.New("em0"),
+				"f0.s0": errors.New("em00"),
+			},
+		}
+
+		// --- When ---
+		have := fs.Unflatten()
+
+		// --- Then ---
+		f0, _ := assert.SameType(t, &GenericFields[EDXrr]{}, have.fields["f0"])
+		assert.ErrorEqual(t, "em0", f0.fields[""])
+		assert.ErrorEqual(t, "em00", f0.fields["s0"])
+		assert.Equal(t, "f0: em0; f0.s0: em00", have.Error())
+	})
+
+	t.Run("empty field name", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{"": errors.New("em0")},
+		}
+
+		// --- When ---
+		have := fs.Unflatten()
+
+		// --- Then ---
+		assert.ErrorEqual(t, "em0", have.fields[""])
+	})
+}
+
 func Test_GenericFields_Filter(t *testing.T) {
 	t.Run("filter", func(t *testing.T) {
 		// --- Given ---
@@ -1260,6 +1467,30 @@ func Test_GenericFields_Get(t *testing.T) {
 		assert.Equal(t, want, have.Error())
 	})
 
+	t.Run("index path", func(t *testing.T) {
+		// --- Given ---
+		fs2 := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"items": &GenericFields[EDXrr]{
+					fields: map[string]error{
+						"[3]": &GenericFields[EDXrr]{
+							fields: map[string]error{
+								"price": errors.New("negative"),
+							},
+						},
+					},
+				},
+				"tags[0]": errors.New("empty"),
+			},
+		}
+
+		// --- Then ---
+		assert.Equal(t, "negative", fs2.Get("items[3].price").Error())
+		assert.Equal(t, "price: negative", fs2.Get("items[3]").Error())
+		assert.Equal(t, "empty", fs2.Get("tags[0]").Error())
+		assert.Nil(t, fs2.Get("items[4].price"))
+	})
+
 	t.Run("the key is not a dot-path prefix of a field", func(t *testing.T) {
 		// --- Given ---
 		fs2 := &GenericFields[EDXrr]{
@@ -1334,169 +1565,462 @@ func Test_GenericFields_Set(t *testing.T) {
 	})
 }
 
-func Test_GenericFields_Len(t *testing.T) {
-	t.Run("non-empty", func(t *testing.T) {
+func Test_GenericFields_SetPath(t *testing.T) {
+	t.Run("creates nested fields", func(t *testing.T) {
 		// --- Given ---
-		fs := &GenericFields[EDXrr]{fields: map[string]error{
-			"f0": errors.New("em0"),
-			"f1": errors.New("em1"),
-		}}
+		fs := &GenericFields[EDXrr]{}
+		pth := Path().Field("items").Index(3).Field("price")
 
 		// --- When ---
-		have := fs.Len()
+		fs.SetPath(pth, ErrTst)
 
 		// --- Then ---
-		assert.Equal(t, 2, have)
+		items, _ := assert.SameType(t, &GenericFields[EDXrr]{}, fs.fields["items"])
+		item, _ := assert.SameType(t, &GenericFields[EDXrr]{}, items.fields["[3]"])
+		assert.Same(t, ErrTst, item.fields["price"])
+		assert.Same(t, ErrTst, fs.Get("items[3].price"))
+		assert.Equal(t, "items[3].price: std tst msg", fs.Error())
 	})
 
-	t.Run("empty fields", func(t *testing.T) {
+	t.Run("reuses existing nested fields", func(t *testing.T) {
 		// --- Given ---
-		fs := &GenericFields[EDXrr]{fields: map[string]error{}}
+		fs := &GenericFields[EDXrr]{}
+		fs.SetPath(Path().Field("items").Index(0).Field("name"), ErrTst)
 
 		// --- When ---
-		have := fs.Len()
+		fs.SetPath(Path().Field("items").Index(1), ErrTst)
 
 		// --- Then ---
-		assert.Equal(t, 0, have)
+		want := "items[0].name: std tst msg; items[1]: std tst msg"
+		assert.Equal(t, want, fs.Error())
 	})
 
-	t.Run("nil receiver returns zero", func(t *testing.T) {
+	t.Run("field error on the way is preserved", func(t *testing.T) {
 		// --- Given ---
-		var fs *GenericFields[EDXrr]
+		other := errors.New("other")
+		fs := &GenericFields[EDXrr]{fields: map[string]error{"items": other}}
 
 		// --- When ---
-		have := fs.Len()
+		fs.SetPath(Path().Field("items").Index(0), ErrTst)
 
 		// --- Then ---
-		assert.Equal(t, 0, have)
+		assert.Equal(t, "items: other; items[0]: std tst msg", fs.Error())
 	})
-}
 
-func Test_GenericFields_MarshalJSON(t *testing.T) {
-	t.Run("with many levels", func(t *testing.T) {
+	t.Run("set error for nested fields", func(t *testing.T) {
 		// --- Given ---
-		fs := &GenericFields[EDXrr]{
-			fields: map[string]error{
-				"f0": &GenericFields[EDXrr]{
-					fields: map[string]error{
-						"s0": New("em00", "ECode00"),
-						"s1": New("em01", "ECode01"),
-						"s2": &GenericFields[EDXrr]{
-							fields: map[string]error{
-								"s0": errors.New("em020"),
-							},
-						},
-					},
-				},
-				"f1": New("em1", "ECode1", Meta().Str("key", "val").Option()),
-				"f2": New("em2", "ECode2"),
-			},
-		}
+		fs := &GenericFields[EDXrr]{}
+		fs.SetPath(Path().Field("items").Index(0), ErrTst)
+		other := errors.New("other")
 
 		// --- When ---
-		have, err := fs.MarshalJSON()
+		fs.SetPath(Path().Field("items"), other)
 
 		// --- Then ---
-		assert.NoError(t, err)
-		want := `{
-			"f0.s0": {"code": "ECode00", "error": "em00"},
-			"f0.s1": {"code": "ECode01", "error": "em01"},
-			"f0.s2.s0": {"code": "ECGeneric", "error": "em020"},
-			"f1": {"code": "ECode1", "error": "em1", "meta": {"key": "val"}},
-			"f2": {"code": "ECode2", "error": "em2"}
-		}`
-		assert.JSON(t, want, string(have))
+		assert.Equal(t, "items: other; items[0]: std tst msg", fs.Error())
 	})
 
-	t.Run("all fields nil returns empty object", func(t *testing.T) {
+	t.Run("empty path is no-op", func(t *testing.T) {
 		// --- Given ---
-		fs := &GenericFields[EDXrr]{
-			fields: map[string]error{
-				"f0": nil,
-				"f1": nil,
-			},
-		}
+		fs := &GenericFields[EDXrr]{}
 
 		// --- When ---
-		have, err := fs.MarshalJSON()
+		fs.SetPath(Path(), ErrTst)
 
 		// --- Then ---
-		assert.NoError(t, err)
-		assert.JSON(t, `{}`, string(have))
+		assert.Nil(t, fs.fields)
 	})
 
-	t.Run("field marshaller error", func(t *testing.T) {
+	t.Run("nil receiver is no-op", func(t *testing.T) {
 		// --- Given ---
-		fs := &GenericFields[EDXrr]{
-			fields: map[string]error{
-				"f0": &TErrMarshalJSON{errors.New("abc")},
-			},
-		}
+		var fs *GenericFields[EDXrr]
+
+		// --- When --- Then --- (must not panic)
+		fs.SetPath(Path().Field("f0"), ErrTst)
+	})
+}
+
+func Test_GenericFields_AddField(t *testing.T) {
+	t.Run("new field", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
 
 		// --- When ---
-		have, err := fs.MarshalJSON()
+		fs.AddField("f0", ErrTst)
 
 		// --- Then ---
-		assert.ErrorEqual(t, "abc", err)
-		assert.Nil(t, have)
+		assert.Same(t, ErrTst, fs.fields["f0"])
 	})
-}
 
-func Test_GenericFields_UnmarshalJSON(t *testing.T) {
-	t.Run("round-trip", func(t *testing.T) {
+	t.Run("appends errors", func(t *testing.T) {
 		// --- Given ---
-		fs := &GenericFields[EDXrr]{
-			fields: map[string]error{
-				"f0": New("em0", "ECode0"),
-				"f1": New("em1", "ECode1", Meta().Str("key", "val").Option()),
-			},
-		}
-		data := must.Value(fs.MarshalJSON())
+		fs := &GenericFields[EDXrr]{}
+		e0 := New("too short", "ECShort")
+		e1 := New("no digit", "ECDigit")
+		e2 := errors.New("no upper")
 
 		// --- When ---
-		var got GenericFields[EDXrr]
-		err := json.Unmarshal(data, &got)
+		fs.AddField("password", e0)
+		fs.AddField("password", e1)
+		fs.AddField("password", e2)
 
 		// --- Then ---
-		assert.NoError(t, err)
-		assert.Len(t, 2, got.fields)
-		assert.ErrorEqual(t, "em0", got.fields["f0"])
-		assert.ErrorEqual(t, "em1", got.fields["f1"])
+		have, _ := assert.SameType(t, fieldErrorList{}, fs.fields["password"])
+		assert.Equal(t, fieldErrorList{e0, e1, e2}, have)
+		want := "password: too short; password: no digit; password: no upper"
+		assert.Equal(t, want, fs.Error())
 	})
 
-	t.Run("empty object yields empty fields", func(t *testing.T) {
+	t.Run("nil field error is replaced", func(t *testing.T) {
 		// --- Given ---
-		var got GenericFields[EDXrr]
+		fs := &GenericFields[EDXrr]{fields: map[string]error{"f0": nil}}
 
 		// --- When ---
-		err := json.Unmarshal([]byte(`{}`), &got)
+		fs.AddField("f0", ErrTst)
 
 		// --- Then ---
-		assert.NoError(t, err)
-		assert.Len(t, 0, got.fields)
+		assert.Same(t, ErrTst, fs.fields["f0"])
 	})
 
-	t.Run("invalid JSON returns error", func(t *testing.T) {
+	t.Run("adds error to nested fields", func(t *testing.T) {
 		// --- Given ---
-		var got GenericFields[EDXrr]
+		fs := &GenericFields[EDXrr]{}
+		fs.SetPath(Path().Field("items").Index(0), ErrTst)
+		other := errors.New("other")
 
 		// --- When ---
-		err := json.Unmarshal([]byte(`not-json`), &got)
+		fs.AddField("items", other)
 
 		// --- Then ---
-		assert.Error(t, err)
+		assert.Equal(t, "items: other; items[0]: std tst msg", fs.Error())
 	})
-}
 
-func Test_Flatten(t *testing.T) {
-	t.Run("flatten single", func(t *testing.T) {
+	t.Run("nested fields added to field with error", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+		e0, e1 := errors.New("em0"), errors.New("em1")
+		fs.AddField("a", e0)
+
+		// --- When ---
+		fs.AddField("a", NewFields[EDXrr](map[string]error{"b": e1}))
+
+		// --- Then ---
+		nested, _ := assert.SameType(t, &GenericFields[EDXrr]{}, fs.fields["a"])
+		assert.Equal(t, map[string]error{"": e0, "b": e1}, nested.fields)
+		assert.Equal(t, []string{"a", "a.b"}, FieldNames(fs.Flatten()))
+		assert.Same(t, e1, fs.Get("a.b"))
+		assert.Equal(t, "a: em0; a.b: em1", fs.Error())
+		wantJSON := `{
+			"a": {"code": "ECGeneric", "error": "em0"},
+			"a.b": {"code": "ECGeneric", "error": "em1"}
+		}`
+		assert.JSON(t, wantJSON, string(must.Value(json.Marshal(fs))))
+	})
+
+	t.Run("nested fields added to field with errors", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+		e0, e1, e2 := errors.New("em0"), errors.New("em1"), errors.New("em2")
+		fs.AddField("a", e0)
+		sub := NewFields[EDXrr](map[string]error{"": e1, "b": e2})
+
+		// --- When ---
+		fs.AddField("a", sub)
+
+		// --- Then ---
+		assert.Equal(t, "a: em0; a: em1; a.b: em2", fs.Error())
+	})
+
+	t.Run("nested fields added to nested fields", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+		e0, e1, e2 := errors.New("em0"), errors.New("em1"), errors.New("em2")
+		fs.SetPath(Path().Field("a").Field("b"), e0)
+		sub := NewFields[EDXrr](map[string]error{"b": e1, "c": e2})
+
+		// --- When ---
+		fs.AddField("a", sub)
+
+		// --- Then ---
+		assert.Equal(t, "a.b: em0; a.b: em1; a.c: em2", fs.Error())
+		assert.Equal(t, []string{"a.b", "a.c"}, FieldNames(fs.Flatten()))
+	})
+
+	t.Run("nested fields added to new field", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+		sub := NewFields[EDXrr](map[string]error{"b": ErrTst})
+
+		// --- When ---
+		fs.AddField("a", sub)
+
+		// --- Then ---
+		assert.Same(t, sub, fs.fields["a"])
+	})
+
+	t.Run("nil error is ignored", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{fields: map[string]error{"f0": ErrTst}}
+
+		// --- When ---
+		fs.AddField("f0", nil)
+		fs.AddField("f1", nil)
+
+		// --- Then ---
+		assert.Equal(t, map[string]error{"f0": ErrTst}, fs.fields)
+	})
+
+	t.Run("ordered", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+
+		// --- When ---
+		fs.AddField("b", errors.New("em0"))
+		fs.AddField("a", errors.New("em1"))
+		fs.AddField("b", errors.New("em2"))
+
+		// --- Then ---
+		assert.Equal(t, []string{"b", "a"}, fs.order)
+		assert.Equal(t, "b: em0; b: em2; a: em1", fs.Error())
+	})
+
+	t.Run("nil receiver is no-op", func(t *testing.T) {
+		// --- Given ---
+		var fs *GenericFields[EDXrr]
+
+		// --- When --- Then --- (must not panic)
+		fs.AddField("f0", ErrTst)
+	})
+}
+
+func Test_GenericFields_AddPath(t *testing.T) {
+	t.Run("appends errors", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+		pth := Path().Field("items").Index(3).Field("price")
+
+		// --- When ---
+		fs.AddPath(pth, errors.New("negative"))
+		fs.AddPath(pth, errors.New("too many decimals"))
+
+		// --- Then ---
+		want := "items[3].price: negative; items[3].price: too many decimals"
+		assert.Equal(t, want, fs.Error())
+		assert.Len(t, 2, Split(fs.Get("items[3].price")))
+	})
+
+	t.Run("adds error to nested fields", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+		fs.AddPath(Path().Field("items").Index(0), ErrTst)
+
+		// --- When ---
+		fs.AddPath(Path().Field("items"), errors.New("e0"))
+		fs.AddPath(Path().Field("items"), errors.New("e1"))
+
+		// --- Then ---
+		want := "items: e0; items: e1; items[0]: std tst msg"
+		assert.Equal(t, want, fs.Error())
+	})
+
+	t.Run("empty path is no-op", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+
+		// --- When ---
+		fs.AddPath(Path(), ErrTst)
+
+		// --- Then ---
+		assert.Nil(t, fs.fields)
+	})
+
+	t.Run("nil error is ignored", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{}
+
+		// --- When ---
+		fs.AddPath(Path().Field("a").Field("b"), nil)
+
+		// --- Then ---
+		assert.Nil(t, fs.fields)
+	})
+
+	t.Run("nil receiver is no-op", func(t *testing.T) {
+		// --- Given ---
+		var fs *GenericFields[EDXrr]
+
+		// --- When --- Then --- (must not panic)
+		fs.AddPath(Path().Field("f0"), ErrTst)
+	})
+}
+
+func Test_GenericFields_multiple_errors(t *testing.T) {
+	e0 := New("too short", "ECShort", Meta().Int("min", 8).Option())
+	e1 := New("no digit", "ECDigit")
+
+	newFields := func() *GenericFields[EDXrr] {
+		fs := &GenericFields[EDXrr]{}
+		fs.AddField("password", e0)
+		fs.AddField("password", e1)
+		fs.Set("name", ErrTst)
+		return fs
+	}
+
+	t.Run("format with codes", func(t *testing.T) {
+		// --- When ---
+		have := fmt.Sprintf("%+v", newFields())
+
+		// --- Then ---
+		want := "name: std tst msg (ECGeneric); " +
+			"password: too short (ECShort); password: no digit (ECDigit)"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("unwrap", func(t *testing.T) {
+		// --- When ---
+		have := newFields().Unwrap()
+
+		// --- Then ---
+		assert.Len(t, 3, have)
+		assert.ErrorEqual(t, "name: std tst msg", have[0])
+		assert.ErrorEqual(t, "password: too short", have[1])
+		assert.ErrorEqual(t, "password: no digit", have[2])
+	})
+
+	t.Run("get", func(t *testing.T) {
+		// --- When ---
+		have := newFields().Get("password")
+
+		// --- Then ---
+		assert.Equal(t, []error{e0, e1}, Split(have))
+		assert.ErrorIs(t, e0, have)
+		assert.ErrorIs(t, e1, have)
+	})
+
+	t.Run("field error is", func(t *testing.T) {
+		// --- Given ---
+		fs := newFields()
+
+		// --- Then ---
+		assert.True(t, FieldErrorIs(fs, "password", e0))
+		assert.True(t, FieldErrorIs(fs, "password", e1))
+		assert.True(t, errors.Is(fs, e1))
+	})
+
+	t.Run("get codes", func(t *testing.T) {
+		// --- When ---
+		have := GetCodes(newFields())
+
+		// --- Then ---
+		assert.Equal(t, []string{"ECGeneric", "ECShort", "ECDigit"}, have)
+		assert.True(t, IsCode(newFields(), "ECDigit"))
+	})
+
+	t.Run("marshal JSON", func(t *testing.T) {
+		// --- When ---
+		have, err := json.Marshal(newFields())
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{
+			"name": {"code": "ECGeneric", "error": "std tst msg"},
+			"password": [
+				{"code": "ECShort", "error": "too short", "meta": {"min": 8}},
+				{"code": "ECDigit", "error": "no digit"}
+			]
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("marshal JSON list style", func(t *testing.T) {
+		// --- When ---
+		have, err := MarshalJSON(newFields(), WithJSONFieldStyle(FieldStyleList))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `[
+			{"field": "name", "code": "ECGeneric", "error": "std tst msg"},
+			{"field": "password", "code": "ECShort", "error": "too short", "meta": {"min": 8}},
+			{"field": "password", "code": "ECDigit", "error": "no digit"}
+		]`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("JSON round-trip in all styles", func(t *testing.T) {
+		styles := []FieldStyle{
+			FieldStyleDot,
+			FieldStyleBracket,
+			FieldStylePointer,
+			FieldStyleNested,
+			FieldStyleList,
+		}
+		for _, style := range styles {
+			// --- Given ---
+			data := must.Value(MarshalJSON(newFields(), WithJSONFieldStyle(style)))
+			fs := &GenericFields[EDXrr]{}
+
+			// --- When ---
+			err := json.Unmarshal(data, fs)
+
+			// --- Then ---
+			assert.NoError(t, err)
+			ers := Split(fs.Get("password"))
+			assert.Len(t, 2, ers)
+			assert.ErrorEqual(t, "too short", ers[0])
+			assert.Equal(t, "ECShort", GetCode(ers[0]))
+			assert.ErrorEqual(t, "no digit", ers[1])
+			assert.Equal(t, "ECDigit", GetCode(ers[1]))
+		}
+	})
+}
+
+func Test_GenericFields_Len(t *testing.T) {
+	t.Run("non-empty", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{fields: map[string]error{
+			"f0": errors.New("em0"),
+			"f1": errors.New("em1"),
+		}}
+
+		// --- When ---
+		have := fs.Len()
+
+		// --- Then ---
+		assert.Equal(t, 2, have)
+	})
+
+	t.Run("empty fields", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{fields: map[string]error{}}
+
+		// --- When ---
+		have := fs.Len()
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+	})
+
+	t.Run("nil receiver returns zero", func(t *testing.T) {
+		// --- Given ---
+		var fs *GenericFields[EDXrr]
+
+		// --- When ---
+		have := fs.Len()
+
+		// --- Then ---
+		assert.Equal(t, 0, have)
+	})
+}
+
+func Test_GenericFields_MarshalJSON(t *testing.T) {
+	t.Run("with many levels", func(t *testing.T) {
 		// --- Given ---
 		fs := &GenericFields[EDXrr]{
 			fields: map[string]error{
 				"f0": &GenericFields[EDXrr]{
 					fields: map[string]error{
-						"s0": errors.New("em00"),
-						"s1": errors.New("em01"),
+						"s0": New("em00", "ECode00"),
+						"s1": New("em01", "ECode01"),
 						"s2": &GenericFields[EDXrr]{
 							fields: map[string]error{
 								"s0": errors.New("em020"),
@@ -1504,61 +2028,251 @@ func Test_Flatten(t *testing.T) {
 						},
 					},
 				},
-				"f1": New("em1", "ECode1"),
+				"f1": New("em1", "ECode1", Meta().Str("key", "val").Option()),
 				"f2": New("em2", "ECode2"),
 			},
 		}
 
 		// --- When ---
-		err := Flatten[EDXrr](fs)
+		have, err := fs.MarshalJSON()
 
 		// --- Then ---
-		want := &GenericFields[EDXrr]{
-			fields: map[string]error{
-				"f0.s0":    errors.New("em00"),
-				"f0.s1":    errors.New("em01"),
-				"f0.s2.s0": errors.New("em020"),
-				"f1":       New("em1", "ECode1"),
-				"f2":       New("em2", "ECode2"),
-			},
-		}
-		assert.Equal(t, want, err)
+		assert.NoError(t, err)
+		want := `{
+			"f0.s0": {"code": "ECode00", "error": "em00"},
+			"f0.s1": {"code": "ECode01", "error": "em01"},
+			"f0.s2.s0": {"code": "ECGeneric", "error": "em020"},
+			"f1": {"code": "ECode1", "error": "em1", "meta": {"key": "val"}},
+			"f2": {"code": "ECode2", "error": "em2"}
+		}`
+		assert.JSON(t, want, string(have))
 	})
 
-	t.Run("flatten multiple", func(t *testing.T) {
+	t.Run("all fields nil returns empty object", func(t *testing.T) {
 		// --- Given ---
-		fs0 := &GenericFields[EDXrr]{
+		fs := &GenericFields[EDXrr]{
 			fields: map[string]error{
-				"f0": &GenericFields[EDXrr]{
-					fields: map[string]error{
-						"s0": errors.New("em00"),
-						"s1": errors.New("em01"),
-						"s2": &GenericFields[EDXrr]{
-							fields: map[string]error{
-								"s0": errors.New("em020"),
-							},
-						},
-					},
-				},
-				"f1": New("em1", "ECode1"),
+				"f0": nil,
+				"f1": nil,
 			},
 		}
 
-		fs1 := &GenericFields[EDXrr]{
+		// --- When ---
+		have, err := fs.MarshalJSON()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.JSON(t, `{}`, string(have))
+	})
+
+	t.Run("field marshaller error", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
 			fields: map[string]error{
-				"f1": New("other", "ECOther"),
-				"f2": New("em2", "ECode2"),
+				"f0": &TErrMarshalJSON{errors.New("abc")},
 			},
 		}
 
 		// --- When ---
-		err := Flatten[EDXrr](fs0, fs1)
+		have, err := fs.MarshalJSON()
 
 		// --- Then ---
-		want := &GenericFields[EDXrr]{
-			fields: map[string]error{
-				"f0.s0":    errors.New("em00"),
-				"f0.s1":    errors.New("em01"),
+		assert.ErrorEqual(t, "abc", err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_GenericFields_UnmarshalJSON(t *testing.T) {
+	t.Run("round-trip", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0": New("em0", "ECode0"),
+				"f1": New("em1", "ECode1", Meta().Str("key", "val").Option()),
+			},
+		}
+		data := must.Value(fs.MarshalJSON())
+
+		// --- When ---
+		var got GenericFields[EDXrr]
+		err := json.Unmarshal(data, &got)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 2, got.fields)
+		assert.ErrorEqual(t, "em0", got.fields["f0"])
+		assert.ErrorEqual(t, "em1", got.fields["f1"])
+	})
+
+	t.Run("empty object yields empty fields", func(t *testing.T) {
+		// --- Given ---
+		var got GenericFields[EDXrr]
+
+		// --- When ---
+		err := json.Unmarshal([]byte(`{}`), &got)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 0, got.fields)
+	})
+
+	t.Run("field styles", func(t *testing.T) {
+		tt := []struct {
+			testN string
+			data  string
+		}{
+			{"dot", `{"items[3].price": {"error": "em0"}}`},
+			{"bracket", `{"items[3][price]": {"error": "em0"}}`},
+			{"pointer", `{"/items/3/price": {"error": "em0"}}`},
+			{"nested", `{"items": {"3": {"price": {"error": "em0"}}}}`},
+			{"list", `[{"field": "items[3].price", "error": "em0"}]`},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.testN, func(t *testing.T) {
+				// --- Given ---
+				var got GenericFields[EDXrr]
+
+				// --- When ---
+				err := json.Unmarshal([]byte(tc.data), &got)
+
+				// --- Then ---
+				assert.NoError(t, err)
+				assert.Len(t, 1, got.fields)
+				assert.ErrorEqual(t, "em0", got.Get("items[3].price"))
+			})
+		}
+	})
+
+	t.Run("empty list yields empty fields", func(t *testing.T) {
+		// --- Given ---
+		var got GenericFields[EDXrr]
+
+		// --- When ---
+		err := json.Unmarshal([]byte(`[]`), &got)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 0, got.fields)
+	})
+
+	t.Run("invalid field error returns error", func(t *testing.T) {
+		// --- Given ---
+		var got GenericFields[EDXrr]
+
+		// --- When ---
+		err := json.Unmarshal([]byte(`{"f0": {}}`), &got)
+
+		// --- Then ---
+		assert.ErrorIs(t, ErrInvJSONError, err)
+	})
+
+	t.Run("nested fields round-trip", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+		fc.Field("items").Index(3).Add("price", New("negative", "ECNeg"))
+		fc.Field("addr").Add("city", New("required", "ECRequired"))
+		fc.Add("name", New("required", "ECRequired"))
+		src := fc.Err()
+		data := must.Value(json.Marshal(src))
+
+		// --- When ---
+		var got GenericFields[EDXrr]
+		err := json.Unmarshal(data, &got)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, FieldNames(src), FieldNames(&got))
+		assert.Equal(t, []string{"addr", "items", "name"}, FieldNames(&got))
+		assert.ErrorEqual(t, "negative", got.Get("items[3].price"))
+		assert.ErrorEqual(t, "price: negative", got.Get("items[3]"))
+		assert.Equal(t, src.Error(), got.Error())
+		assert.JSON(t, string(data), string(must.Value(json.Marshal(&got))))
+	})
+
+	t.Run("invalid JSON returns error", func(t *testing.T) {
+		// --- Given ---
+		var got GenericFields[EDXrr]
+
+		// --- When ---
+		err := json.Unmarshal([]byte(`not-json`), &got)
+
+		// --- Then ---
+		assert.Error(t, err)
+	})
+}
+
+func Test_Flatten(t *testing.T) {
+	t.Run("flatten single", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0": &GenericFields[EDXrr]{
+					fields: map[string]error{
+						"s0": errors.New("em00"),
+						"s1": errors.New("em01"),
+						"s2": &GenericFields[EDXrr]{
+							fields: map[string]error{
+								"s0": errors.New("em020"),
+							},
+						},
+					},
+				},
+				"f1": New("em1", "ECode1"),
+				"f2": New("em2", "ECode2"),
+			},
+		}
+
+		// --- When ---
+		err := Flatten[EDXrr](fs)
+
+		// --- Then ---
+		want := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0.s0":    errors.New("em00"),
+				"f0.s1":    errors.New("em01"),
+				"f0.s2.s0": errors.New("em020"),
+				"f1":       New("em1", "ECode1"),
+				"f2":       New("em2", "ECode2"),
+			},
+		}
+		assert.Equal(t, want, err)
+	})
+
+	t.Run("flatten multiple", func(t *testing.T) {
+		// --- Given ---
+		fs0 := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0": &GenericFields[EDXrr]{
+					fields: map[string]error{
+						"s0": errors.New("em00"),
+						"s1": errors.New("em01"),
+						"s2": &GenericFields[EDXrr]{
+							fields: map[string]error{
+								"s0": errors.New("em020"),
+							},
+						},
+					},
+				},
+				"f1": New("em1", "ECode1"),
+			},
+		}
+
+		fs1 := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f1": New("other", "ECOther"),
+				"f2": New("em2", "ECode2"),
+			},
+		}
+
+		// --- When ---
+		err := Flatten[EDXrr](fs0, fs1)
+
+		// --- Then ---
+		want := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0.s0":    errors.New("em00"),
+				"f0.s1":    errors.New("em01"),
 				"f0.s2.s0": errors.New("em020"),
 				"f1":       New("other", "ECOther"),
 				"f2":       New("em2", "ECode2"),
@@ -1567,3 +2281,596 @@ func Test_Flatten(t *testing.T) {
 		assert.Equal(t, want, err)
 	})
 }
+
+func Test_Unflatten(t *testing.T) {
+	t.Run("unflatten multiple", func(t *testing.T) {
+		// --- Given ---
+		fs0 := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0.s0": errors.New("em00"),
+				"f1":    New("em1", "ECode1"),
+			},
+		}
+		fs1 := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f1":    New("other", "ECOther"),
+				"f2[0]": New("em20", "ECode20"),
+			},
+		}
+
+		// --- When ---
+		err := Unflatten[EDXrr](fs0, fs1)
+
+		// --- Then ---
+		want := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0": &GenericFields[EDXrr]{
+					fields: map[string]error{"s0": errors.New("em00")},
+				},
+				"f1": New("other", "ECOther"),
+				"f2": &GenericFields[EDXrr]{
+					fields: map[string]error{"[0]": New("em20", "ECode20")},
+				},
+			},
+		}
+		assert.Equal(t, want, err)
+	})
+
+	t.Run("inverse of flatten", func(t *testing.T) {
+		// --- Given ---
+		fs := &GenericFields[EDXrr]{
+			fields: map[string]error{
+				"f0": &GenericFields[EDXrr]{
+					fields: map[string]error{
+						"s0": errors.New("em00"),
+						"s1": &GenericFields[EDXrr]{
+							fields: map[string]error{
+								"[2]": errors.New("em012"),
+							},
+						},
+					},
+				},
+				"f1": New("em1", "ECode1"),
+			},
+		}
+
+		// --- When ---
+		err := Unflatten[EDXrr](Flatten[EDXrr](fs))
+
+		// --- Then ---
+		assert.Equal(t, fs, err)
+	})
+
+	t.Run("non fielder error", func(t *testing.T) {
+		// --- When ---
+		err := Unflatten[EDXrr](errors.New("em0"))
+
+		// --- Then ---
+		assert.Equal(t, "__field__0: em0", err.Error())
+	})
+}
+
+func Test_GenericFields_ordered(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+
+		// --- When ---
+		fs.Set("c", errors.New("em2"))
+		fs.Set("a", errors.New("em0"))
+		fs.Set("c", errors.New("em3"))
+
+		// --- Then ---
+		assert.Equal(t, []string{"c", "a"}, fs.order)
+		assert.Equal(t, "c: em3; a: em0", fs.Error())
+		assert.Equal(t, []string{"c", "a"}, FieldNames(fs))
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("z", errors.New("em0"))
+
+		// --- When ---
+		fs.Merge(map[string]error{
+			"c": errors.New("em2"),
+			"b": errors.New("em1"),
+			"z": errors.New("other"),
+		})
+
+		// --- Then ---
+		assert.Equal(t, []string{"z", "b", "c"}, fs.order)
+		assert.Equal(t, "z: em0; b: em1; c: em2", fs.Error())
+	})
+
+	t.Run("set path creates ordered nested fields", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+
+		// --- When ---
+		fs.SetPath(Path().Field("z").Field("y"), errors.New("em0"))
+		fs.SetPath(Path().Field("z").Field("b"), errors.New("em1"))
+		fs.Set("a", errors.New("em2"))
+
+		// --- Then ---
+		assert.Equal(t, []string{"z", "a"}, fs.order)
+		assert.Equal(t, "z.y: em0; z.b: em1; a: em2", fs.Error())
+	})
+
+	t.Run("unwrap", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("b", errors.New("em1"))
+		fs.Set("n", nil)
+		fs.Set("a", errors.New("em0"))
+
+		// --- When ---
+		have := fs.Unwrap()
+
+		// --- Then ---
+		assert.Len(t, 2, have)
+		assert.ErrorEqual(t, "b: em1", have[0])
+		assert.ErrorEqual(t, "a: em0", have[1])
+	})
+
+	t.Run("format with codes", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("b", New("em1", "EC1"))
+		fs.Set("a", New("em0", "EC0"))
+
+		// --- When ---
+		have := fmt.Sprintf("%+v", fs)
+
+		// --- Then ---
+		assert.Equal(t, "b: em1 (EC1); a: em0 (EC0)", have)
+	})
+
+	t.Run("flatten", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("b", errors.New("em1"))
+		sub := NewOrderedFields[EDXrr]()
+		sub.Set("y", errors.New("em01"))
+		sub.Set("x", errors.New("em00"))
+		fs.Set("a", sub)
+
+		// --- When ---
+		have := fs.Flatten()
+
+		// --- Then ---
+		assert.Equal(t, []string{"b", "a.y", "a.x"}, have.order)
+		assert.Equal(t, "b: em1; a.y: em01; a.x: em00", have.Error())
+	})
+
+	t.Run("nested unordered fields are sorted", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("b", errors.New("em1"))
+		fs.Set("a", NewFieldErrors(map[string]error{
+			"y": errors.New("em01"),
+			"x": errors.New("em00"),
+		}))
+
+		// --- When ---
+		have := fs.Error()
+
+		// --- Then ---
+		assert.Equal(t, "b: em1; a.x: em00; a.y: em01", have)
+	})
+
+	t.Run("unordered with nested ordered fields is sorted", func(t *testing.T) {
+		// --- Given ---
+		sub := NewOrderedFields[EDXrr]()
+		sub.Set("y", errors.New("em01"))
+		sub.Set("x", errors.New("em00"))
+		fs := NewFieldErrors(map[string]error{"a": sub})
+
+		// --- When ---
+		have := fs.Error()
+
+		// --- Then ---
+		assert.Equal(t, "a.x: em00; a.y: em01", have)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("c", errors.New("em2"))
+		fs.Set("b", nil)
+		fs.Set("a", errors.New("em0"))
+
+		// --- When ---
+		have := fs.Filter()
+
+		// --- Then ---
+		fls, _ := assert.SameType(t, &GenericFields[EDXrr]{}, have)
+		assert.Equal(t, []string{"c", "a"}, fls.order)
+	})
+
+	t.Run("unflatten", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("b.y", errors.New("em11"))
+		fs.Set("a", errors.New("em0"))
+		fs.Set("b.x", errors.New("em10"))
+
+		// --- When ---
+		have := fs.Unflatten()
+
+		// --- Then ---
+		assert.Equal(t, []string{"b", "a"}, have.order)
+		assert.Equal(t, "b.y: em11; b.x: em10; a: em0", have.Error())
+	})
+
+	t.Run("merge fields", func(t *testing.T) {
+		// --- Given ---
+		fs0 := NewOrderedFields[EDXrr]()
+		fs0.Set("z", errors.New("em0"))
+		fs0.Set("y", errors.New("em1"))
+		fs1 := NewFieldErrors(map[string]error{
+			"b": errors.New("em3"),
+			"a": errors.New("em2"),
+			"z": errors.New("other"),
+		})
+
+		// --- When ---
+		have := MergeFields[EDXrr](fs0, errors.New("em4"), fs1)
+
+		// --- Then ---
+		fls, _ := assert.SameType(t, &GenericFields[EDXrr]{}, have)
+		want := []string{"z", "y", "__field__1", "a", "b"}
+		assert.Equal(t, want, fls.order)
+	})
+
+	t.Run("flatten function", func(t *testing.T) {
+		// --- Given ---
+		fs0 := NewOrderedFields[EDXrr]()
+		fs0.Set("z", NewFieldError("s", errors.New("em0")))
+		fs1 := NewOrderedFields[EDXrr]()
+		fs1.Set("b", errors.New("em1"))
+
+		// --- When ---
+		have := Flatten[EDXrr](fs0, fs1)
+
+		// --- Then ---
+		fls, _ := assert.SameType(t, &GenericFields[EDXrr]{}, have)
+		assert.Equal(t, []string{"z.s", "b"}, fls.order)
+	})
+
+	t.Run("unflatten function", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("z.s", errors.New("em0"))
+		fs.Set("b", errors.New("em1"))
+
+		// --- When ---
+		have := Unflatten[EDXrr](fs)
+
+		// --- Then ---
+		fls, _ := assert.SameType(t, &GenericFields[EDXrr]{}, have)
+		assert.Equal(t, []string{"z", "b"}, fls.order)
+	})
+
+	t.Run("marshal JSON", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("name", New("em1", "EC1"))
+		fs.SetPath(Path().Field("items").Index(1), New("em0", "EC0"))
+		fs.Set("age", nil)
+
+		// --- When ---
+		have, err := fs.MarshalJSON()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{` +
+			`"name":{"code":"EC1","error":"em1"},` +
+			`"items[1]":{"code":"EC0","error":"em0"}` +
+			`}`
+		assert.Equal(t, want, string(have))
+	})
+
+	t.Run("marshal JSON styles", func(t *testing.T) {
+		tt := []struct {
+			testN string
+
+			style FieldStyle
+			want  string
+		}{
+			{
+				"pointer",
+				FieldStylePointer,
+				`{"/b/1":{"code":"EC0","error":"em0"},"/a":{"code":"EC1","error":"em1"}}`,
+			},
+			{
+				"nested",
+				FieldStyleNested,
+				`{"b":{"1":{"code":"EC0","error":"em0"}},"a":{"code":"EC1","error":"em1"}}`,
+			},
+			{
+				"list",
+				FieldStyleList,
+				`[{"code":"EC0","error":"em0","field":"b[1]"},{"code":"EC1","error":"em1","field":"a"}]`,
+			},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.testN, func(t *testing.T) {
+				// --- Given ---
+				fs := NewOrderedFields[EDXrr]()
+				fs.SetPath(Path().Field("b").Index(1), New("em0", "EC0"))
+				fs.Set("a", New("em1", "EC1"))
+
+				// --- When ---
+				have, err := MarshalJSON(fs, WithJSONFieldStyle(tc.style))
+
+				// --- Then ---
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, string(have))
+			})
+		}
+	})
+
+	t.Run("envelope", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("b", New("em1", "EC1"))
+		fs.Set("a", New("em0", "EC0"))
+
+		// --- When ---
+		have, err := json.Marshal(Enclose(fs, New("lead", "ECLead")))
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `{"code":"ECLead","error":"lead","fields":{` +
+			`"b":{"code":"EC1","error":"em1"},` +
+			`"a":{"code":"EC0","error":"em0"}` +
+			`}}`
+		assert.Equal(t, want, string(have))
+	})
+}
+
+func Test_mergeOrder(t *testing.T) {
+	t.Run("no ordered errors", func(t *testing.T) {
+		// --- Given ---
+		fs := NewFieldErrors(map[string]error{"a": ErrTst})
+
+		// --- When ---
+		have := mergeOrder(fs, nil, ErrTst)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("ordered errors", func(t *testing.T) {
+		// --- Given ---
+		fs0 := NewFieldErrors(map[string]error{"b": ErrTst, "a": ErrTst})
+		fs1 := NewOrderedFields[EDXrr]()
+		fs1.Set("d", ErrTst)
+		fs1.Set("c", ErrTst)
+
+		// --- When ---
+		have := mergeOrder(fs0, nil, ErrTst, fs1)
+
+		// --- Then ---
+		assert.Equal(t, []string{"a", "b", "__field__2", "d", "c"}, have)
+	})
+}
+
+func Test_flatFields(t *testing.T) {
+	t.Run("sorted", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{
+			"b": ErrTst,
+			"a": NewFieldErrors(map[string]error{"z": ErrTst, "y": ErrTst}),
+		}
+
+		// --- When ---
+		flat, names := flatFields(fields, nil)
+
+		// --- Then ---
+		want := map[string]error{"a.y": ErrTst, "a.z": ErrTst, "b": ErrTst}
+		assert.Equal(t, want, flat)
+		assert.Equal(t, []string{"a.y", "a.z", "b"}, names)
+	})
+
+	t.Run("ordered", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{
+			"b": ErrTst,
+			"a": NewFieldErrors(map[string]error{"z": ErrTst, "y": ErrTst}),
+		}
+
+		// --- When ---
+		flat, names := flatFields(fields, []string{"b", "a"})
+
+		// --- Then ---
+		assert.Len(t, 3, flat)
+		assert.Equal(t, []string{"b", "a.y", "a.z"}, names)
+	})
+
+	t.Run("duplicated flattened name", func(t *testing.T) {
+		// --- Given ---
+		fields := map[string]error{
+			"a.b": ErrTst,
+			"a":   NewFieldErrors(map[string]error{"b": ErrTst}),
+		}
+
+		// --- When ---
+		flat, names := flatFields(fields, []string{"a.b", "a"})
+
+		// --- Then ---
+		assert.Len(t, 1, flat)
+		assert.Equal(t, []string{"a.b"}, names)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		flat, names := flatFields(nil, nil)
+
+		// --- Then ---
+		assert.Len(t, 0, flat)
+		assert.NotNil(t, names)
+		assert.Len(t, 0, names)
+	})
+}
+
+func Test_fieldNames_tabular(t *testing.T) {
+	fields := map[string]error{"a": nil, "b": nil, "c": nil, "d": nil}
+
+	tt := []struct {
+		testN string
+
+		order []string
+		want  []string
+	}{
+		{"nil order", nil, []string{"a", "b", "c", "d"}},
+		{"empty order", []string{}, []string{"a", "b", "c", "d"}},
+		{"full order", []string{"d", "b", "c", "a"}, []string{"d", "b", "c", "a"}},
+		{"partial order", []string{"c", "a"}, []string{"c", "a", "b", "d"}},
+		{"unknown names", []string{"x", "c"}, []string{"c", "a", "b", "d"}},
+		{"duplicates", []string{"c", "c", "a"}, []string{"c", "a", "b", "d"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := fieldNames(fields, tc.order)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_orderOf(t *testing.T) {
+	t.Run("ordered", func(t *testing.T) {
+		// --- Given ---
+		fs := NewOrderedFields[EDXrr]()
+		fs.Set("b", ErrTst)
+
+		// --- When ---
+		have := orderOf(fs)
+
+		// --- Then ---
+		assert.Equal(t, []string{"b"}, have)
+	})
+
+	t.Run("not ordered", func(t *testing.T) {
+		// --- When ---
+		have := orderOf(NewFieldErrors(map[string]error{"a": ErrTst}))
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("not GenericFields", func(t *testing.T) {
+		// --- When ---
+		have := orderOf(TFielderCoder{})
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
+func Test_fieldErrorList(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		fe := fieldErrorList{errors.New("em0"), errors.New("em1")}
+
+		// --- When ---
+		have := fe.Error()
+
+		// --- Then ---
+		assert.Equal(t, "em0; em1", have)
+	})
+
+	t.Run("unwrap returns copy", func(t *testing.T) {
+		// --- Given ---
+		fe := fieldErrorList{ErrTst, ErrTst}
+
+		// --- When ---
+		have := fe.Unwrap()
+
+		// --- Then ---
+		assert.Equal(t, []error{ErrTst, ErrTst}, have)
+		have[0] = nil
+		assert.Same(t, ErrTst, fe[0])
+	})
+
+	t.Run("marshal JSON", func(t *testing.T) {
+		// --- Given ---
+		fe := fieldErrorList{New("em0", "EC0"), errors.New("em1")}
+
+		// --- When ---
+		have, err := fe.MarshalJSON()
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := `[
+			{"code": "EC0", "error": "em0"},
+			{"code": "ECGeneric", "error": "em1"}
+		]`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("error - marshal JSON", func(t *testing.T) {
+		// --- Given ---
+		fe := fieldErrorList{ErrTst, &TErrMarshalJSON{err: errors.New("msg a")}}
+
+		// --- When ---
+		have, err := fe.MarshalJSON()
+
+		// --- Then ---
+		assert.ErrorContain(t, "msg a", err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_addFieldError(t *testing.T) {
+	t.Run("no previous error", func(t *testing.T) {
+		// --- When ---
+		have := addFieldError(nil, ErrTst)
+
+		// --- Then ---
+		assert.Same(t, ErrTst, have)
+	})
+
+	t.Run("previous error", func(t *testing.T) {
+		// --- Given ---
+		e0 := errors.New("em0")
+
+		// --- When ---
+		have := addFieldError(e0, ErrTst)
+
+		// --- Then ---
+		assert.Equal(t, fieldErrorList{e0, ErrTst}, have)
+	})
+
+	t.Run("lists are concatenated", func(t *testing.T) {
+		// --- Given ---
+		e0, e1, e2 := errors.New("em0"), errors.New("em1"), errors.New("em2")
+
+		// --- When ---
+		have := addFieldError(fieldErrorList{e0}, fieldErrorList{e1, e2})
+
+		// --- Then ---
+		assert.Equal(t, fieldErrorList{e0, e1, e2}, have)
+	})
+
+	t.Run("previous list is not modified", func(t *testing.T) {
+		// --- Given ---
+		e0, e1 := errors.New("em0"), errors.New("em1")
+		prev := make(fieldErrorList, 1, 4)
+		prev[0] = e0
+
+		// --- When ---
+		have0 := addFieldError(prev, e1)
+		have1 := addFieldError(prev, ErrTst)
+
+		// --- Then ---
+		assert.Equal(t, fieldErrorList{e0, e1}, have0)
+		assert.Equal(t, fieldErrorList{e0, ErrTst}, have1)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 	"unsafe"
 )
@@ -106,27 +107,84 @@ func isNil(v any) bool {
 	}
 }
 
-// prefix adds prefix to the key if the prefix is not empty.
+// prefix adds prefix to the key if the prefix is not empty. The keys
+// starting with the index in square brackets are not separated with a dot.
 func prefix(pref, key string) string {
 	if pref != "" {
 		if key == "" {
 			return pref
 		}
+		if key[0] == '[' {
+			return pref + key
+		}
 		return pref + "." + key
 	}
 	return key
 }
 
+// cutPrefix returns the field without the prefix added with [prefix] and
+// true. Returns false if the field does not start with the prefix.
+func cutPrefix(field, pref string) (string, bool) {
+	suffix, ok := strings.CutPrefix(field, pref)
+	if !ok || suffix == "" {
+		return "", false
+	}
+	switch suffix[0] {
+	case '.':
+		return suffix[1:], true
+	case '[':
+		return suffix, true
+	}
+	return "", false
+}
+
 // isTypeSupported returns true if the type of v is the supported metadata type.
+// Groups are supported when all their values are of supported types.
 func isTypeSupported(v any) bool {
-	switch v.(type) {
-	case bool, string, int, int64, float64, time.Time, time.Duration:
+	switch x := v.(type) {
+	case bool, string, int, int64, uint64, float64, time.Time, time.Duration,
+		[]string, []int:
+		return true
+	case map[string]any:
+		for _, value := range x {
+			if !isTypeSupported(value) {
+				return false
+			}
+		}
 		return true
 	default:
 		return false
 	}
 }
 
+// cloneMetaValue returns a deep copy of the slice and group metadata values.
+// Values of other types are returned as they are.
+func cloneMetaValue(v any) any {
+	switch x := v.(type) {
+	case []string:
+		return slices.Clone(x)
+	case []int:
+		return slices.Clone(x)
+	case map[string]any:
+		return cloneMeta(x)
+	default:
+		return v
+	}
+}
+
+// cloneMeta returns a deep copy of the metadata map (see [cloneMetaValue]).
+// Returns nil when the map is nil.
+func cloneMeta(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	ret := make(map[string]any, len(m))
+	for key, value := range m {
+		ret[key] = cloneMetaValue(value)
+	}
+	return ret
+}
+
 // sortFields converts a map of errors to two slices: one for field names and
 // one for errors. The returned slices maintain corresponding indexes, ensuring
 // that each field name aligns with its associated error. Both slices are
@@ -166,33 +224,166 @@ func errorMessage(err error) string {
 	return err.Error()
 }
 
-// marshalError marshals error to JSON with check if the resulting JSON message
-// is an empty object "{}", which means error did not have a [json.Marshaler]
-// interface implemented, in which case the provided error is wrapped in the
-// [GenericError] instance and marshaled again.
-func marshalError(e error) ([]byte, error) {
+// marshalError marshals error to JSON. Errors implementing [jsonEncoder] are
+// encoded using ops. Otherwise, it checks if the resulting JSON message is an
+// empty object "{}", which means error did not have a [json.Marshaler]
+// interface implemented, in which case the error is represented by a map
+// returned from [errorAsMap].
+func marshalError(e error, ops JSONOptions) ([]byte, error) {
+	if enc, ok := e.(jsonEncoder); ok { // nolint: errorlint
+		return enc.encodeJSON(ops)
+	}
 	data, err := json.Marshal(e)
 	if err != nil {
 		return nil, err
 	}
 	if len(data) == 2 {
-		return json.Marshal(errorAsMap(e))
+		return json.Marshal(errorAsMap(e, ops))
 	}
 	return data, nil
 }
 
-// errorAsMap returns a map representation of the error. Returns the nil map
-// when the given error is nil.
-func errorAsMap(err error) map[string]any {
+// errorAsMap returns a map representation of the error configured with ops.
+// Returns the nil map when the given error is nil.
+func errorAsMap(err error, ops JSONOptions) map[string]any {
 	if err == nil {
 		return nil
 	}
+	if ops.causes {
+		switch node := errorTree(err, ops).(type) {
+		case map[string]any:
+			return node
+		case []any:
+			return map[string]any{
+				"error": err.Error(),
+				"code":  GetCode(err),
+				"cause": node,
+			}
+		}
+	}
 	m := map[string]any{
 		"error": err.Error(),
 		"code":  GetCode(err),
 	}
+	setDomain(m, err)
+	setMessage(m, err, ops)
+	if ops.template {
+		if tpl := GetTemplate(err); tpl != "" {
+			m["template"] = tpl
+		}
+	}
 	if meta := GetMeta(err); len(meta) > 0 {
 		m["meta"] = meta
+		if ops.metaTypes {
+			m["meta_types"] = metaTypes(meta)
+		}
+	}
+	if ops.stack {
+		if stack := GetStack(err); len(stack) > 0 {
+			m["stack"] = stack
+		}
 	}
 	return m
 }
+
+// errorTree returns the representation of the error chain (tree) used by the
+// [WithJSONCauses] option. The tree is traversed the same way as in [walk]:
+// errors wrapping other errors are represented as maps with the "cause" key,
+// [Fielder] errors as maps with the "fields" key and joined errors as slices.
+// Returns nil when the given error is nil.
+func errorTree(err error, ops JSONOptions) any {
+	if err == nil || isNil(err) {
+		return nil
+	}
+	switch x := err.(type) { // nolint: errorlint
+	case interface{ Unwrap() error }:
+		m := errorNode(err, ops)
+		if cause := errorTree(x.Unwrap(), ops); cause != nil {
+			m["cause"] = cause
+		}
+		return m
+
+	case Fielder:
+		m := map[string]any{"error": err.Error()}
+		if _, ok := err.(Coder); ok {
+			m = errorNode(err, ops)
+		}
+		fields := make(map[string]any, len(x.ErrorFields()))
+		for field, fe := range x.ErrorFields() {
+			if node := errorTree(fe, ops); node != nil {
+				fields[field] = node
+			}
+		}
+		m["fields"] = fields
+		return m
+
+	case joined:
+		ers := x.Unwrap()
+		ret := make([]any, 0, len(ers))
+		for _, je := range ers {
+			if node := errorTree(je, ops); node != nil {
+				ret = append(ret, node)
+			}
+		}
+		return ret
+	}
+	return errorNode(err, ops)
+}
+
+// errorNode returns a map representation of a single error in the error
+// chain (tree) with its own metadata and stack. See [errorTree].
+func errorNode(err error, ops JSONOptions) map[string]any {
+	m := map[string]any{
+		"error": err.Error(),
+		"code":  GetCode(err),
+	}
+	setDomain(m, err)
+	setMessage(m, err, ops)
+	if ops.template {
+		if e, ok := err.(Templater); ok && e.ErrorTemplate() != "" {
+			m["template"] = e.ErrorTemplate()
+		}
+	}
+	if e, ok := err.(Metadater); ok {
+		if meta := e.MetaAll(); len(meta) > 0 {
+			m["meta"] = meta
+			if ops.metaTypes {
+				m["meta_types"] = metaTypes(meta)
+			}
+		}
+	}
+	if ops.stack {
+		if e, ok := err.(Stacker); ok {
+			if stack := e.ErrorStack(); len(stack) > 0 {
+				m["stack"] = stack
+			}
+		}
+	}
+	return m
+}
+
+// setDomain sets the "domain" key to the name of the error domain when the
+// domain marker type implements [DomainNamer].
+func setDomain(m map[string]any, err error) {
+	if e, ok := err.(interface{ namedDomain() string }); ok {
+		if name := e.namedDomain(); name != "" {
+			m["domain"] = name
+		}
+	}
+}
+
+// setMessage sets the "error" key to the localized error message when the
+// language is set with [WithJSONLanguage] and there is a template for the
+// error code.
+func setMessage(m map[string]any, err error, ops JSONOptions) {
+	if ops.lang == "" {
+		return
+	}
+	cat := ops.catalog
+	if cat == nil {
+		cat = messages
+	}
+	if msg, ok := cat.localize(err, ops.lang); ok {
+		m["error"] = msg
+	}
+}
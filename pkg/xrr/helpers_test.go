@@ -418,6 +418,9 @@ func Test_prefix_tabular(t *testing.T) {
 		{"2", "pref", "key", "pref.key"},
 		{"3", "pref", "", "pref"},
 		{"4", "", "", ""},
+		{"5", "pref", "[1]", "pref[1]"},
+		{"6", "", "[1]", "[1]"},
+		{"7", "pref[1]", "key", "pref[1].key"},
 	}
 
 	for _, tc := range tt {
@@ -431,6 +434,35 @@ func Test_prefix_tabular(t *testing.T) {
 	}
 }
 
+func Test_cutPrefix_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		field  string
+		prefix string
+		want   string
+		ok     bool
+	}{
+		{"dot", "pref.key", "pref", "key", true},
+		{"index", "pref[1].key", "pref", "[1].key", true},
+		{"index after index", "pref[1][2]", "pref[1]", "[2]", true},
+		{"equal", "pref", "pref", "", false},
+		{"no separator", "prefix", "pref", "", false},
+		{"no prefix", "key", "pref", "", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, ok := cutPrefix(tc.field, tc.prefix)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+}
+
 func Test_isTypeSupported_tabular(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -444,8 +476,14 @@ func Test_isTypeSupported_tabular(t *testing.T) {
 		{"int64", int64(42), true},
 		{"float64", 4.2, true},
 		{"time", time.Now(), true},
+		{"uint64", uint64(42), true},
 		{"duration", time.Second, true},
+		{"strings", []string{"a"}, true},
+		{"ints", []int{1}, true},
+		{"group", map[string]any{"A": 1, "B": map[string]any{"C": "c"}}, true},
+		{"group with not supported", map[string]any{"A": struct{}{}}, false},
 		{"not supported", struct{}{}, false},
+		{"not supported slice", []int64{1}, false},
 	}
 
 	for _, tc := range tt {
@@ -459,6 +497,84 @@ func Test_isTypeSupported_tabular(t *testing.T) {
 	}
 }
 
+func Test_cloneMetaValue(t *testing.T) {
+	t.Run("scalar", func(t *testing.T) {
+		// --- When ---
+		have := cloneMetaValue(42)
+
+		// --- Then ---
+		assert.Equal(t, 42, have)
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		// --- Given ---
+		v := []string{"a"}
+
+		// --- When ---
+		have := cloneMetaValue(v)
+
+		// --- Then ---
+		v[0] = "x"
+		assert.Equal(t, []string{"a"}, have)
+	})
+
+	t.Run("ints", func(t *testing.T) {
+		// --- Given ---
+		v := []int{1}
+
+		// --- When ---
+		have := cloneMetaValue(v)
+
+		// --- Then ---
+		v[0] = 2
+		assert.Equal(t, []int{1}, have)
+	})
+
+	t.Run("group", func(t *testing.T) {
+		// --- Given ---
+		v := map[string]any{"A": []int{1}}
+
+		// --- When ---
+		have := cloneMetaValue(v)
+
+		// --- Then ---
+		v["A"].([]int)[0] = 2
+		v["B"] = 3
+		assert.Equal(t, map[string]any{"A": []int{1}}, have)
+	})
+}
+
+func Test_cloneMeta(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		// --- When ---
+		have := cloneMeta(nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have := cloneMeta(map[string]any{})
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{}, have)
+	})
+
+	t.Run("deep copy", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"A": 1, "B": map[string]any{"C": []string{"c"}}}
+
+		// --- When ---
+		have := cloneMeta(m)
+
+		// --- Then ---
+		m["B"].(map[string]any)["C"].([]string)[0] = "x"
+		want := map[string]any{"A": 1, "B": map[string]any{"C": []string{"c"}}}
+		assert.Equal(t, want, have)
+	})
+}
+
 func Test_sortFields(t *testing.T) {
 	// --- Given ---
 	fs := map[string]error{
@@ -491,7 +607,7 @@ func Test_marshalError(t *testing.T) {
 		e := errors.New("e")
 
 		// --- When ---
-		have, err := marshalError(e)
+		have, err := marshalError(e, JSONOptions{})
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -503,7 +619,7 @@ func Test_marshalError(t *testing.T) {
 		e := New("msg a", "a")
 
 		// --- When ---
-		data, err := marshalError(e)
+		data, err := marshalError(e, JSONOptions{})
 
 		// --- Then ---
 		assert.NoError(t, err)
@@ -515,7 +631,7 @@ func Test_marshalError(t *testing.T) {
 		e := &TErrMarshalJSON{errors.New("e")}
 
 		// --- When ---
-		have, err := marshalError(e)
+		have, err := marshalError(e, JSONOptions{})
 
 		// --- Then ---
 		wMsg := "json: " +
@@ -528,7 +644,7 @@ func Test_marshalError(t *testing.T) {
 func Test_errorAsMap(t *testing.T) {
 	t.Run("nil error", func(t *testing.T) {
 		// --- When ---
-		have := errorAsMap(nil)
+		have := errorAsMap(nil, JSONOptions{})
 
 		// --- Then ---
 		assert.Nil(t, have)
@@ -539,7 +655,7 @@ func Test_errorAsMap(t *testing.T) {
 		e := errors.New("m0")
 
 		// --- When ---
-		have := errorAsMap(e)
+		have := errorAsMap(e, JSONOptions{})
 
 		// --- Then ---
 		want := map[string]any{"code": "ECGeneric", "error": "m0"}
@@ -552,7 +668,7 @@ func Test_errorAsMap(t *testing.T) {
 		e := Wrap(errors.New("m0"), WithMeta(m))
 
 		// --- When ---
-		have := errorAsMap(e)
+		have := errorAsMap(e, JSONOptions{})
 
 		// --- Then ---
 		want := map[string]any{
@@ -562,4 +678,250 @@ func Test_errorAsMap(t *testing.T) {
 		}
 		assert.Equal(t, want, have)
 	})
+	t.Run("with causes", func(t *testing.T) {
+		// --- Given ---
+		e := New("m0", "EC0", WithCause(New("m1", "EC1")))
+
+		// --- When ---
+		have := errorAsMap(e, JSONOptions{causes: true})
+
+		// --- Then ---
+		want := map[string]any{
+			"error": "m0: m1",
+			"code":  "EC0",
+			"cause": map[string]any{"error": "m1", "code": "EC1"},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("joined errors with causes", func(t *testing.T) {
+		// --- Given ---
+		e := errors.Join(New("m0", "EC0"), New("m1", "EC1"))
+
+		// --- When ---
+		have := errorAsMap(e, JSONOptions{causes: true})
+
+		// --- Then ---
+		want := map[string]any{
+			"error": "m0\nm1",
+			"code":  "ECGeneric",
+			"cause": []any{
+				map[string]any{"error": "m0", "code": "EC0"},
+				map[string]any{"error": "m1", "code": "EC1"},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_setDomain(t *testing.T) {
+	t.Run("named domain", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{}
+
+		// --- When ---
+		setDomain(m, &GenericError[edNamed]{})
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"domain": "named"}, m)
+	})
+
+	t.Run("not named domain", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{}
+
+		// --- When ---
+		setDomain(m, &GenericError[EDXrr]{})
+
+		// --- Then ---
+		assert.Len(t, 0, m)
+	})
+
+	t.Run("not domain error", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{}
+
+		// --- When ---
+		setDomain(m, errors.New("msg"))
+
+		// --- Then ---
+		assert.Len(t, 0, m)
+	})
+}
+
+func Test_setMessage(t *testing.T) {
+	cat := NewMessageCatalog()
+	cat.Add("de", map[string]string{"ECode": "Fehler"})
+
+	t.Run("localized", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"error": "msg"}
+		ops := JSONOptions{lang: "de", catalog: cat}
+
+		// --- When ---
+		setMessage(m, New("msg", "ECode"), ops)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"error": "Fehler"}, m)
+	})
+
+	t.Run("no template", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"error": "msg"}
+		ops := JSONOptions{lang: "pl", catalog: cat}
+
+		// --- When ---
+		setMessage(m, New("msg", "ECode"), ops)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"error": "msg"}, m)
+	})
+
+	t.Run("no language", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{"error": "msg"}
+		ops := JSONOptions{catalog: cat}
+
+		// --- When ---
+		setMessage(m, New("msg", "ECode"), ops)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"error": "msg"}, m)
+	})
+}
+
+func Test_errorTree(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have := errorTree(nil, JSONOptions{})
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("leaf error", func(t *testing.T) {
+		// --- Given ---
+		e := errors.New("m0")
+
+		// --- When ---
+		have := errorTree(e, JSONOptions{})
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"error": "m0", "code": "ECGeneric"}, have)
+	})
+
+	t.Run("own metadata", func(t *testing.T) {
+		// --- Given ---
+		e := Wrap(
+			New("m0", "EC0", Meta().Int("A", 1).Option()),
+			Meta().Int("B", 2).Option(),
+		)
+
+		// --- When ---
+		have := errorTree(e, JSONOptions{metaTypes: true})
+
+		// --- Then ---
+		want := map[string]any{
+			"error":      "m0",
+			"code":       "EC0",
+			"meta":       map[string]any{"B": 2},
+			"meta_types": map[string]any{"B": "int"},
+			"cause": map[string]any{
+				"error":      "m0",
+				"code":       "EC0",
+				"meta":       map[string]any{"A": 1},
+				"meta_types": map[string]any{"A": "int"},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		// --- Given ---
+		e := NewFieldErrors(map[string]error{
+			"f0": New("m0", "EC0"),
+			"f1": nil,
+			"f2": NewFieldError("f3", New("m3", "EC3")),
+		})
+
+		// --- When ---
+		have := errorTree(e, JSONOptions{})
+
+		// --- Then ---
+		want := map[string]any{
+			"error": "f0: m0; f2.f3: m3",
+			"fields": map[string]any{
+				"f0": map[string]any{"error": "m0", "code": "EC0"},
+				"f2": map[string]any{
+					"error": "f3: m3",
+					"fields": map[string]any{
+						"f3": map[string]any{"error": "m3", "code": "EC3"},
+					},
+				},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("fields with code", func(t *testing.T) {
+		// --- Given ---
+		e := TFielderCoder{
+			code:   "ECFielder",
+			fields: map[string]error{"f0": New("m0", "EC0")},
+		}
+
+		// --- When ---
+		have := errorTree(e, JSONOptions{})
+
+		// --- Then ---
+		want := map[string]any{
+			"error": "fielder coder",
+			"code":  "ECFielder",
+			"fields": map[string]any{
+				"f0": map[string]any{"error": "m0", "code": "EC0"},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("joined errors", func(t *testing.T) {
+		// --- Given ---
+		e := errors.Join(New("m0", "EC0"), errors.Join(New("m1", "EC1")))
+
+		// --- When ---
+		have := errorTree(e, JSONOptions{})
+
+		// --- Then ---
+		want := []any{
+			map[string]any{"error": "m0", "code": "EC0"},
+			[]any{map[string]any{"error": "m1", "code": "EC1"}},
+		}
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_errorNode(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		e := New("m0", "EC0", WithCause(New("m1", "EC1")))
+
+		// --- When ---
+		have := errorNode(e, JSONOptions{})
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"error": "m0: m1", "code": "EC0"}, have)
+	})
+
+	t.Run("with stack", func(t *testing.T) {
+		// --- Given ---
+		e := Wrap(New("m0", "EC0", WithStack()))
+
+		// --- When ---
+		have := errorNode(e, JSONOptions{stack: true})
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"error": "m0", "code": "EC0"}, have)
+		have = errorNode(errors.Unwrap(e), JSONOptions{stack: true})
+		assert.HasKey(t, "stack", have)
+	})
 }
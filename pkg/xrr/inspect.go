@@ -36,13 +36,15 @@ func GetCode(err error) string {
 }
 
 // GetCodes recursively retrieves a unique list of error codes from an error
-// and its wrapped errors, ignoring empty error codes.
-func GetCodes(err error) []string {
+// and its wrapped errors, ignoring empty error codes. When patterns are
+// provided, only the codes matching at least one of them are returned (see
+// [CodeMatch]).
+func GetCodes(err error, patterns ...string) []string {
 	set := make(map[string]struct{})
 	var ret []string
 	cb := func(err error) bool {
 		code := GetCode(err)
-		if code == "" {
+		if code == "" || !matchCodes(code, patterns...) {
 			return true
 		}
 		if _, ok := set[code]; !ok {
@@ -55,6 +57,21 @@ func GetCodes(err error) []string {
 	return ret
 }
 
+// GetTemplate walks the error chain (tree) and returns the first non-empty
+// message template (see [Templater]). Returns an empty string if none of the
+// errors were created from a template.
+func GetTemplate(err error) string {
+	var tpl string
+	cb := func(err error) bool {
+		if e, ok := err.(Templater); ok {
+			tpl = e.ErrorTemplate()
+		}
+		return tpl == ""
+	}
+	walk(err, cb)
+	return tpl
+}
+
 // GetMeta recursively retrieves metadata from an error and its wrapped errors.
 //
 // The error chain (tree) is traversed in reverse depth-first order so that
@@ -107,6 +124,13 @@ func GetInt64(err error, key string) (int64, bool) {
 	return getKey[int64](err, key)
 }
 
+// GetUint64 recursively walks the error chain (tree) and returns the first
+// uint64 value associated with the provided key. Returns the key value and
+// true if the key was found. Otherwise, returns a zero value and false.
+func GetUint64(err error, key string) (uint64, bool) {
+	return getKey[uint64](err, key)
+}
+
 // GetFloat64 recursively walks the error chain (tree) and returns the first
 // float64 value associated with the provided key. Returns the key value and
 // true if the key was found. Otherwise, returns a zero value and false.
@@ -128,11 +152,37 @@ func GetDuration(err error, key string) (time.Duration, bool) {
 	return getKey[time.Duration](err, key)
 }
 
+// GetStrs recursively walks the error chain (tree) and returns the first
+// string slice associated with the provided key. Returns the key value and
+// true if the key was found. Otherwise, returns nil and false. The returned
+// slice is a copy of the metadata value.
+func GetStrs(err error, key string) ([]string, bool) {
+	return getKey[[]string](err, key)
+}
+
+// GetInts recursively walks the error chain (tree) and returns the first
+// integer slice associated with the provided key. Returns the key value and
+// true if the key was found. Otherwise, returns nil and false. The returned
+// slice is a copy of the metadata value.
+func GetInts(err error, key string) ([]int, bool) {
+	return getKey[[]int](err, key)
+}
+
+// GetGroup recursively walks the error chain (tree) and returns the first
+// metadata group associated with the provided key. Returns the key value and
+// true if the key was found. Otherwise, returns nil and false.
+//
+// The group values are not merged, the group found first is returned whole.
+// The returned group is a deep copy of the metadata value.
+func GetGroup(err error, key string) (map[string]any, bool) {
+	return getKey[map[string]any](err, key)
+}
+
 // getKey recursively walks the error chain (tree) and returns the first value
 // of type T associated with the provided key. Returns the key value and true
 // if the key was found with the correct type. Otherwise, returns a zero value
 // and false.
-func getKey[T MetaType](err error, key string) (T, bool) {
+func getKey[T metaValue](err error, key string) (T, bool) {
 	var value T
 	var found bool
 	cb := func(err error) bool {
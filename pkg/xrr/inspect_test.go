@@ -118,6 +118,41 @@ func Test_GetCode_tabular(t *testing.T) {
 	}
 }
 
+func Test_GetTemplate_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		err  error
+		want string
+	}{
+		{"nil error", nil, ""},
+		{"standard error", errors.New("msg"), ""},
+		{"no template", New("msg", "ECode"), ""},
+		{"template", NewTemplate("{a}", "ECode"), "{a}"},
+		{"wrapped template", Wrap(NewTemplate("{a}", "ECode")), "{a}"},
+		{
+			"first template",
+			NewTemplate("{a}", "EC0", WithCause(NewTemplate("{b}", "EC1"))),
+			"{a}",
+		},
+		{
+			"joined templates",
+			errors.Join(New("m", "EC0"), NewTemplate("{b}", "EC1")),
+			"{b}",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := GetTemplate(tc.err)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
 func Test_GetCodes_tabular(t *testing.T) {
 	var err error
 
@@ -216,6 +251,48 @@ func Test_GetCodes_tabular(t *testing.T) {
 	}
 }
 
+func Test_GetCodes_patterns(t *testing.T) {
+	tree := func() error {
+		return New("m0", "DB.Timeout", WithCause(errors.Join(
+			New("m1", "DB.Conflict.Unique"),
+			New("m2", "HTTP.NotFound"),
+			New("m3", "ECGeneric"),
+		)))
+	}
+
+	t.Run("single pattern", func(t *testing.T) {
+		// --- When ---
+		have := GetCodes(tree(), "DB.**")
+
+		// --- Then ---
+		assert.Equal(t, []string{"DB.Timeout", "DB.Conflict.Unique"}, have)
+	})
+
+	t.Run("multiple patterns", func(t *testing.T) {
+		// --- When ---
+		have := GetCodes(tree(), "DB.*", "HTTP.*")
+
+		// --- Then ---
+		assert.Equal(t, []string{"DB.Timeout", "HTTP.NotFound"}, have)
+	})
+
+	t.Run("flat code", func(t *testing.T) {
+		// --- When ---
+		have := GetCodes(tree(), "ECGeneric")
+
+		// --- Then ---
+		assert.Equal(t, []string{"ECGeneric"}, have)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		// --- When ---
+		have := GetCodes(tree(), "Cache.**")
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+}
+
 func Test_GetMeta_tabular(t *testing.T) {
 	var err error
 
@@ -480,6 +557,42 @@ func Test_GetInt64(t *testing.T) {
 	}
 }
 
+func Test_GetUint64(t *testing.T) {
+	tree := func() error {
+		return &GenericError[EDXrr]{
+			meta: map[string]any{"A": uint64(1)},
+			err: &GenericError[EDXrr]{
+				meta: map[string]any{"A": uint64(2), "B": "3"},
+			},
+		}
+	}
+
+	tt := []struct {
+		testN string
+
+		err   error
+		key   string
+		value uint64
+		exist bool
+	}{
+		{"nil error", nil, "key", 0, false},
+		{"not existing key", tree(), "X", 0, false},
+		{"returns the first found key", tree(), "A", uint64(1), true},
+		{"key found but type mismatch", tree(), "B", 0, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			value, exist := GetUint64(tc.err, tc.key)
+
+			// --- Then ---
+			assert.Equal(t, tc.exist, exist)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
+
 func Test_GetFloat64(t *testing.T) {
 	tree := func() error {
 		return &GenericError[EDXrr]{
@@ -590,6 +703,155 @@ func Test_GetDuration(t *testing.T) {
 	}
 }
 
+func Test_GetStrs(t *testing.T) {
+	tree := func() error {
+		return &GenericError[EDXrr]{
+			meta: map[string]any{"A": []string{"a"}},
+			err: &GenericError[EDXrr]{
+				meta: map[string]any{"A": []string{"b"}, "B": "3"},
+			},
+		}
+	}
+
+	tt := []struct {
+		testN string
+
+		err   error
+		key   string
+		value []string
+		exist bool
+	}{
+		{"nil error", nil, "key", nil, false},
+		{"not existing key", tree(), "X", nil, false},
+		{"returns the first found key", tree(), "A", []string{"a"}, true},
+		{"key found but type mismatch", tree(), "B", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			value, exist := GetStrs(tc.err, tc.key)
+
+			// --- Then ---
+			assert.Equal(t, tc.exist, exist)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+
+	t.Run("returns a copy", func(t *testing.T) {
+		// --- Given ---
+		err := New("msg", "ECode", Meta().Strs("A", "a").Option())
+		value, _ := GetStrs(err, "A")
+
+		// --- When ---
+		value[0] = "x"
+
+		// --- Then ---
+		have, _ := GetStrs(err, "A")
+		assert.Equal(t, []string{"a"}, have)
+	})
+}
+
+func Test_GetInts(t *testing.T) {
+	tree := func() error {
+		return &GenericError[EDXrr]{
+			meta: map[string]any{"A": []int{1}},
+			err: &GenericError[EDXrr]{
+				meta: map[string]any{"A": []int{2}, "B": "3"},
+			},
+		}
+	}
+
+	tt := []struct {
+		testN string
+
+		err   error
+		key   string
+		value []int
+		exist bool
+	}{
+		{"nil error", nil, "key", nil, false},
+		{"not existing key", tree(), "X", nil, false},
+		{"returns the first found key", tree(), "A", []int{1}, true},
+		{"key found but type mismatch", tree(), "B", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			value, exist := GetInts(tc.err, tc.key)
+
+			// --- Then ---
+			assert.Equal(t, tc.exist, exist)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+
+	t.Run("returns a copy", func(t *testing.T) {
+		// --- Given ---
+		err := New("msg", "ECode", Meta().Ints("A", 1).Option())
+		value, _ := GetInts(err, "A")
+
+		// --- When ---
+		value[0] = 2
+
+		// --- Then ---
+		have, _ := GetInts(err, "A")
+		assert.Equal(t, []int{1}, have)
+	})
+}
+
+func Test_GetGroup(t *testing.T) {
+	tree := func() error {
+		return &GenericError[EDXrr]{
+			meta: map[string]any{"A": map[string]any{"C": 1}},
+			err: &GenericError[EDXrr]{
+				meta: map[string]any{"A": map[string]any{"C": 2}, "B": "3"},
+			},
+		}
+	}
+
+	tt := []struct {
+		testN string
+
+		err   error
+		key   string
+		value map[string]any
+		exist bool
+	}{
+		{"nil error", nil, "key", nil, false},
+		{"not existing key", tree(), "X", nil, false},
+		{"returns the first found key", tree(), "A", map[string]any{"C": 1}, true},
+		{"key found but type mismatch", tree(), "B", nil, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			value, exist := GetGroup(tc.err, tc.key)
+
+			// --- Then ---
+			assert.Equal(t, tc.exist, exist)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+
+	t.Run("returns a deep copy", func(t *testing.T) {
+		// --- Given ---
+		meta := Meta().Group("A", Meta().Ints("B", 1))
+		err := New("msg", "ECode", meta.Option())
+		value, _ := GetGroup(err, "A")
+
+		// --- When ---
+		value["B"].([]int)[0] = 2
+		value["C"] = 3
+
+		// --- Then ---
+		have, _ := GetGroup(err, "A")
+		assert.Equal(t, map[string]any{"B": []int{1}}, have)
+	})
+}
+
 func Test_getKey(t *testing.T) {
 	tt := []struct {
 		testN string
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"strings"
+	"sync"
+)
+
+// messages is the default message catalog.
+var messages = NewMessageCatalog()
+
+// MessageCatalog represents a collection of localized message templates for
+// error codes. It is safe for concurrent use.
+//
+// Templates may refer to the error metadata (see [GetMeta]) with the key
+// name in curly braces, for example, "Amount {amount} exceeds the limit".
+// References to keys missing in the metadata are rendered as
+// "%!key(MISSING)". Literal braces are written as "{{" and "}}".
+type MessageCatalog struct {
+	msgs map[string]map[string]string // Templates by language and code.
+	mx   sync.RWMutex
+}
+
+// NewMessageCatalog returns a new empty instance of [MessageCatalog].
+func NewMessageCatalog() *MessageCatalog {
+	return &MessageCatalog{msgs: make(map[string]map[string]string)}
+}
+
+// DefaultMessageCatalog returns the default [MessageCatalog] instance used by
+// [AddMessages], [LoadMessages], [Localize] and [WithJSONLanguage].
+func DefaultMessageCatalog() *MessageCatalog { return messages }
+
+// AddMessages adds message templates by code for the language to the default
+// catalog. See [MessageCatalog.Add].
+func AddMessages(lang string, msgs map[string]string) { messages.Add(lang, msgs) }
+
+// LoadMessages loads message templates from the files matching the pattern
+// to the default catalog. See [MessageCatalog.Load].
+func LoadMessages(fsys fs.FS, pattern string) error { return messages.Load(fsys, pattern) }
+
+// Localize returns the error message in the language using the default
+// catalog. See [MessageCatalog.Localize].
+func Localize(err error, lang string) string { return messages.Localize(err, lang) }
+
+// Add adds message templates by code for the language. Templates for codes
+// already in the catalog are replaced.
+func (cat *MessageCatalog) Add(lang string, msgs map[string]string) {
+	cat.mx.Lock()
+	defer cat.mx.Unlock()
+	if cat.msgs[lang] == nil {
+		cat.msgs[lang] = make(map[string]string, len(msgs))
+	}
+	maps.Copy(cat.msgs[lang], msgs)
+}
+
+// Load loads message templates from the JSON files matching the pattern (see
+// [fs.Glob]), for example, files embedded with [embed.FS]. The file name
+// without the extension is the language, and the file content is a JSON
+// object with templates by code:
+//
+//	// locales/de.json
+//	{
+//	  "EC_NOT_FOUND": "Nicht gefunden",
+//	  "EC_LIMIT": "Betrag {amount} überschreitet das Limit"
+//	}
+//
+// Templates are added to the catalog with [MessageCatalog.Add]. Returns an
+// error when the pattern is malformed or any of the files cannot be read or
+// decoded, in which case no templates are added.
+func (cat *MessageCatalog) Load(fsys fs.FS, pattern string) error {
+	pths, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return err
+	}
+	all := make(map[string]map[string]string, len(pths))
+	for _, pth := range pths {
+		data, err := fs.ReadFile(fsys, pth)
+		if err != nil {
+			return err
+		}
+		var msgs map[string]string
+		if err = json.Unmarshal(data, &msgs); err != nil {
+			return fmt.Errorf("%s: %w", pth, err)
+		}
+		name := path.Base(pth)
+		all[strings.TrimSuffix(name, path.Ext(name))] = msgs
+	}
+	for lang, msgs := range all {
+		cat.Add(lang, msgs)
+	}
+	return nil
+}
+
+// Template returns the message template for the code in the language. When
+// there is no template for the language with a region, for example, "de-AT",
+// the template for its base language ("de") is returned.
+func (cat *MessageCatalog) Template(code, lang string) (string, bool) {
+	cat.mx.RLock()
+	defer cat.mx.RUnlock()
+	if tpl, ok := cat.msgs[lang][code]; ok {
+		return tpl, true
+	}
+	if base, _, found := strings.Cut(lang, "-"); found {
+		tpl, ok := cat.msgs[base][code]
+		return tpl, ok
+	}
+	return "", false
+}
+
+// Localize returns the error message in the language. The message is the
+// template for the error code (see [GetCode]) rendered with the error
+// metadata (see [GetMeta]). Returns the error message when there is no
+// template for the code. Returns an empty string when err is nil.
+func (cat *MessageCatalog) Localize(err error, lang string) string {
+	if err == nil {
+		return ""
+	}
+	if msg, ok := cat.localize(err, lang); ok {
+		return msg
+	}
+	return err.Error()
+}
+
+// localize returns the error message in the language and true. Returns false
+// when there is no template for the error code.
+func (cat *MessageCatalog) localize(err error, lang string) (string, bool) {
+	tpl, ok := cat.Template(GetCode(err), lang)
+	if !ok {
+		return "", false
+	}
+	return renderMessage(tpl, GetMeta(err)), true
+}
+
+// renderMessage renders the message template replacing the metadata key
+// references in curly braces with the metadata values. The "{{" and "}}"
+// sequences are rendered as literal braces.
+func renderMessage(tpl string, meta map[string]any) string {
+	var b strings.Builder
+	for i := 0; i < len(tpl); i++ {
+		c := tpl[i]
+		if (c == '{' || c == '}') && i+1 < len(tpl) && tpl[i+1] == c {
+			b.WriteByte(c)
+			i++
+			continue
+		}
+		if c != '{' {
+			b.WriteByte(c)
+			continue
+		}
+		end := strings.IndexByte(tpl[i:], '}')
+		if end < 0 {
+			b.WriteString(tpl[i:])
+			break
+		}
+		key := tpl[i+1 : i+end]
+		if v, ok := meta[key]; ok {
+			b.WriteString(fmt.Sprint(v))
+		} else {
+			b.WriteString("%!" + key + "(MISSING)")
+		}
+		i += end
+	}
+	return b.String()
+}
@@ -0,0 +1,385 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"errors"
+	"path"
+	"testing"
+	"testing/fstest"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+)
+
+func Test_NewMessageCatalog(t *testing.T) {
+	// --- When ---
+	have := NewMessageCatalog()
+
+	// --- Then ---
+	assert.NotNil(t, have.msgs)
+	assert.Len(t, 0, have.msgs)
+}
+
+func Test_DefaultMessageCatalog(t *testing.T) {
+	// --- When ---
+	have := DefaultMessageCatalog()
+
+	// --- Then ---
+	assert.Same(t, messages, have)
+}
+
+func Test_AddMessages(t *testing.T) {
+	// --- Given ---
+	t.Cleanup(func() { messages = NewMessageCatalog() })
+	messages = NewMessageCatalog()
+
+	// --- When ---
+	AddMessages("de", map[string]string{"ECTstLocalize": "Fehler"})
+
+	// --- Then ---
+	assert.Equal(t, "Fehler", Localize(New("error", "ECTstLocalize"), "de"))
+}
+
+func Test_LoadMessages(t *testing.T) {
+	// --- Given ---
+	t.Cleanup(func() { messages = NewMessageCatalog() })
+	messages = NewMessageCatalog()
+	fsys := fstest.MapFS{
+		"locales/pl.json": {Data: []byte(`{"ECTstLocalize": "Błąd"}`)},
+	}
+
+	// --- When ---
+	err := LoadMessages(fsys, "locales/*.json")
+
+	// --- Then ---
+	assert.NoError(t, err)
+	assert.Equal(t, "Błąd", Localize(New("error", "ECTstLocalize"), "pl"))
+}
+
+func Test_MessageCatalog_Add(t *testing.T) {
+	t.Run("new language", func(t *testing.T) {
+		// --- Given ---
+		cat := NewMessageCatalog()
+		msgs := map[string]string{"EC0": "m0"}
+
+		// --- When ---
+		cat.Add("de", msgs)
+
+		// --- Then ---
+		assert.Equal(t, map[string]map[string]string{"de": {"EC0": "m0"}}, cat.msgs)
+		msgs["EC0"] = "changed"
+		assert.Equal(t, "m0", cat.msgs["de"]["EC0"])
+	})
+
+	t.Run("existing language", func(t *testing.T) {
+		// --- Given ---
+		cat := NewMessageCatalog()
+		cat.Add("de", map[string]string{"EC0": "m0", "EC1": "m1"})
+
+		// --- When ---
+		cat.Add("de", map[string]string{"EC1": "x1", "EC2": "x2"})
+
+		// --- Then ---
+		want := map[string]string{"EC0": "m0", "EC1": "x1", "EC2": "x2"}
+		assert.Equal(t, want, cat.msgs["de"])
+	})
+}
+
+func Test_MessageCatalog_Load(t *testing.T) {
+	t.Run("load", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"locales/de.json":    {Data: []byte(`{"EC0": "de0", "EC1": "de1"}`)},
+			"locales/pl-PL.json": {Data: []byte(`{"EC0": "pl0"}`)},
+			"locales/README.md":  {Data: []byte(`readme`)},
+		}
+		cat := NewMessageCatalog()
+
+		// --- When ---
+		err := cat.Load(fsys, "locales/*.json")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]map[string]string{
+			"de":    {"EC0": "de0", "EC1": "de1"},
+			"pl-PL": {"EC0": "pl0"},
+		}
+		assert.Equal(t, want, cat.msgs)
+	})
+
+	t.Run("no matching files", func(t *testing.T) {
+		// --- Given ---
+		cat := NewMessageCatalog()
+
+		// --- When ---
+		err := cat.Load(fstest.MapFS{}, "locales/*.json")
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Len(t, 0, cat.msgs)
+	})
+
+	t.Run("error - invalid pattern", func(t *testing.T) {
+		// --- Given ---
+		cat := NewMessageCatalog()
+
+		// --- When ---
+		err := cat.Load(fstest.MapFS{}, "[")
+
+		// --- Then ---
+		assert.ErrorIs(t, path.ErrBadPattern, err)
+	})
+
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		// --- Given ---
+		fsys := fstest.MapFS{
+			"de.json": {Data: []byte(`{"EC0": "de0"}`)},
+			"pl.json": {Data: []byte(`{"EC0": 0}`)},
+		}
+		cat := NewMessageCatalog()
+
+		// --- When ---
+		err := cat.Load(fsys, "*.json")
+
+		// --- Then ---
+		assert.ErrorContain(t, "pl.json: json: cannot unmarshal number", err)
+		assert.Len(t, 0, cat.msgs)
+	})
+}
+
+func Test_MessageCatalog_Template_tabular(t *testing.T) {
+	cat := NewMessageCatalog()
+	cat.Add("de", map[string]string{"EC0": "de0", "EC1": "de1"})
+	cat.Add("de-AT", map[string]string{"EC0": "at0"})
+
+	tt := []struct {
+		testN string
+
+		code   string
+		lang   string
+		want   string
+		wantOK bool
+	}{
+		{"language", "EC0", "de", "de0", true},
+		{"language with region", "EC0", "de-AT", "at0", true},
+		{"base language", "EC1", "de-AT", "de1", true},
+		{"base language of unknown region", "EC0", "de-CH", "de0", true},
+		{"unknown code", "EC2", "de", "", false},
+		{"unknown language", "EC0", "pl", "", false},
+		{"unknown language with region", "EC0", "pl-PL", "", false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, ok := cat.Template(tc.code, tc.lang)
+
+			// --- Then ---
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_MessageCatalog_Localize(t *testing.T) {
+	cat := NewMessageCatalog()
+	cat.Add("de", map[string]string{
+		"EC0":     "Betrag {amount} {currency} überschreitet das Limit",
+		"EC1":     "Feld {name} fehlt",
+		ECGeneric: "Unbekannter Fehler",
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have := cat.Localize(nil, "de")
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		// --- Given ---
+		meta := Meta().Int("amount", 100).Str("currency", "EUR").Option()
+		e := New("amount exceeds limit", "EC0", meta)
+
+		// --- When ---
+		have := cat.Localize(e, "de")
+
+		// --- Then ---
+		assert.Equal(t, "Betrag 100 EUR überschreitet das Limit", have)
+	})
+
+	t.Run("metadata from wrapped errors", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "EC1", WithCause(New("cause", "ECC", Meta().Str("name", "email").Option())))
+
+		// --- When ---
+		have := cat.Localize(e, "de")
+
+		// --- Then ---
+		assert.Equal(t, "Feld email fehlt", have)
+	})
+
+	t.Run("missing metadata", func(t *testing.T) {
+		// --- Given ---
+		e := New("amount exceeds limit", "EC0", Meta().Int("amount", 100).Option())
+
+		// --- When ---
+		have := cat.Localize(e, "de")
+
+		// --- Then ---
+		assert.Equal(t, "Betrag 100 %!currency(MISSING) überschreitet das Limit", have)
+	})
+
+	t.Run("standard error", func(t *testing.T) {
+		// --- When ---
+		have := cat.Localize(errors.New("msg"), "de")
+
+		// --- Then ---
+		assert.Equal(t, "Unbekannter Fehler", have)
+	})
+
+	t.Run("no template", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "EC0")
+
+		// --- When ---
+		have := cat.Localize(e, "pl")
+
+		// --- Then ---
+		assert.Equal(t, "msg", have)
+	})
+}
+
+func Test_renderMessage_tabular(t *testing.T) {
+	meta := map[string]any{"a": 1, "b": "x"}
+
+	tt := []struct {
+		testN string
+
+		tpl  string
+		want string
+	}{
+		{"empty", "", ""},
+		{"no references", "abc", "abc"},
+		{"references", "{a} and {b}", "1 and x"},
+		{"adjacent references", "{a}{b}", "1x"},
+		{"missing key", "{c}", "%!c(MISSING)"},
+		{"empty key", "{}", "%!(MISSING)"},
+		{"not closed", "a {b", "a {b"},
+		{"closing only", "a} b", "a} b"},
+		{"escaped braces", "{{a}}", "{a}"},
+		{"escaped braces around reference", "{{{a}}}", "{1}"},
+		{"escaped opening brace", "a {{ b", "a { b"},
+		{"escaped closing brace", "a }} b", "a } b"},
+		{"escaped brace in not closed", "{{a} {b", "{a} {b"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := renderMessage(tc.tpl, meta)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_MarshalJSON_localized(t *testing.T) {
+	cat := NewMessageCatalog()
+	cat.Add("de", map[string]string{
+		"ECLead":  "Anfrage ungültig",
+		"ECCause": "Ursache {key}",
+	})
+
+	t.Run("envelope with errors", func(t *testing.T) {
+		// --- Given ---
+		cause := Join(
+			New("cause", "ECCause", Meta().Int("key", 1).Option()),
+			New("other", "ECOther"),
+		)
+		e := Enclose(cause, New("lead", "ECLead"))
+
+		// --- When ---
+		have := must.Value(MarshalJSON(
+			e,
+			WithJSONLanguage("de"),
+			WithJSONMessageCatalog(cat),
+		))
+
+		// --- Then ---
+		want := `{
+			"error": "Anfrage ungültig",
+			"code": "ECLead",
+			"errors": [
+				{"error": "Ursache 1", "code": "ECCause", "meta": {"key": 1}},
+				{"error": "other", "code": "ECOther"}
+			]
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("envelope with fields", func(t *testing.T) {
+		// --- Given ---
+		cause := NewFieldErrors(map[string]error{
+			"f": New("cause", "ECCause", Meta().Str("key", "v").Option()),
+		})
+		e := Enclose(cause, New("lead", "ECLead"))
+
+		// --- When ---
+		have := must.Value(MarshalJSON(
+			e,
+			WithJSONLanguage("de"),
+			WithJSONMessageCatalog(cat),
+		))
+
+		// --- Then ---
+		want := `{
+			"error": "Anfrage ungültig",
+			"code": "ECLead",
+			"fields": {
+				"f": {"error": "Ursache v", "code": "ECCause", "meta": {"key": "v"}}
+			}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("causes", func(t *testing.T) {
+		// --- Given ---
+		e := New("lead", "ECLead", WithCause(New("cause", "ECCause")))
+
+		// --- When ---
+		have := must.Value(MarshalJSON(
+			e,
+			WithJSONCauses(),
+			WithJSONLanguage("de"),
+			WithJSONMessageCatalog(cat),
+		))
+
+		// --- Then ---
+		want := `{
+			"error": "Anfrage ungültig",
+			"code": "ECLead",
+			"cause": {"error": "Ursache %!key(MISSING)", "code": "ECCause"}
+		}`
+		assert.JSON(t, want, string(have))
+	})
+
+	t.Run("default catalog", func(t *testing.T) {
+		// --- Given ---
+		t.Cleanup(func() { messages = NewMessageCatalog() })
+		messages = cat
+
+		// --- When ---
+		have := must.Value(json.Marshal(Enclose(New("lead", "ECLead"))))
+		loc := must.Value(MarshalJSON(Enclose(New("lead", "ECLead")), WithJSONLanguage("de")))
+
+		// --- Then ---
+		assert.JSON(t, `{"error": "lead", "code": "ECLead"}`, string(have))
+		assert.JSON(t, `{"error": "Anfrage ungültig", "code": "ECLead"}`, string(loc))
+	})
+}
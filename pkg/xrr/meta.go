@@ -4,12 +4,25 @@
 package xrr
 
 import (
+	"slices"
 	"time"
 )
 
-// MetaType lists supported metadata types.
+// MetaType lists supported scalar metadata types. Besides them, the metadata
+// supports string and integer slices (see [Metadata.Strs], [Metadata.Ints])
+// and groups of metadata keys represented as map[string]any with values of
+// supported types (see [Metadata.Group]). Slices and groups are deep copied
+// when stored in the error metadata and when returned from it, so the
+// metadata of an error cannot be modified by the callers.
 type MetaType interface {
-	bool | string | int | int64 | float64 | time.Time | time.Duration
+	bool | string | int | int64 | uint64 | float64 | time.Time |
+		time.Duration
+}
+
+// metaValue lists all supported metadata types, including the slices and
+// groups which, unlike [MetaType], are not comparable.
+type metaValue interface {
+	MetaType | []string | []int | map[string]any
 }
 
 // Metadata represents a metadata collection.
@@ -54,6 +67,12 @@ func (m Metadata) Int64(key string, value int64) Metadata {
 	return m.set(key, value)
 }
 
+// Uint64 adds the key with uint64 val to the metadata collection. Key will
+// be overridden with the new value if it already exists.
+func (m Metadata) Uint64(key string, value uint64) Metadata {
+	return m.set(key, value)
+}
+
 // Float64 adds the key with float64 val to the metadata collection. Key will
 // be overridden with the new value if it already exists.
 func (m Metadata) Float64(key string, value float64) Metadata {
@@ -72,8 +91,32 @@ func (m Metadata) Duration(key string, value time.Duration) Metadata {
 	return m.set(key, value)
 }
 
+// Strs adds the key with a copy of the string slice to the metadata
+// collection. Key will be overridden with the new value if it already exists.
+func (m Metadata) Strs(key string, value ...string) Metadata {
+	return m.set(key, slices.Clone(value))
+}
+
+// Ints adds the key with a copy of the integer slice to the metadata
+// collection. Key will be overridden with the new value if it already exists.
+func (m Metadata) Ints(key string, value ...int) Metadata {
+	return m.set(key, slices.Clone(value))
+}
+
+// Group adds the key with a deep copy of the metadata collection as a nested
+// group. Key will be overridden with the new value if it already exists.
+//
+//	meta := Meta().Group("limit", Meta().Int("max", 10).Int("have", 11))
+func (m Metadata) Group(key string, value Metadata) Metadata {
+	group := cloneMeta(value.m)
+	if group == nil {
+		group = make(map[string]any)
+	}
+	return m.set(key, group)
+}
+
 // MetaSetAll copies all metadata from the given map. Only the supported types
-// will be copied.
+// will be copied, slices and groups are deep copied.
 func (m Metadata) MetaSetAll(meta map[string]any) Metadata {
 	for key, value := range meta {
 		if !isTypeSupported(value) {
@@ -82,13 +125,13 @@ func (m Metadata) MetaSetAll(meta map[string]any) Metadata {
 		if m.m == nil {
 			m.m = make(map[string]any, len(meta))
 		}
-		m.m[key] = value
+		m.m[key] = cloneMetaValue(value)
 	}
 	return m
 }
 
 // MetaSetFrom copies all metadata from the given [Metadater] instance. Only
-// the supported types will be copied.
+// the supported types will be copied, slices and groups are deep copied.
 func (m Metadata) MetaSetFrom(meta Metadater) Metadata {
 	all := meta.MetaAll()
 	for key, value := range all {
@@ -98,7 +141,7 @@ func (m Metadata) MetaSetFrom(meta Metadater) Metadata {
 		if m.m == nil {
 			m.m = make(map[string]any, len(all))
 		}
-		m.m[key] = value
+		m.m[key] = cloneMetaValue(value)
 	}
 	return m
 }
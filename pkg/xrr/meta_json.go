@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Metadata type tags used in the "meta_types" JSON object.
+const (
+	MetaTypeBool     = "bool"
+	MetaTypeString   = "string"
+	MetaTypeInt      = "int"
+	MetaTypeInt64    = "int64"
+	MetaTypeUint64   = "uint64"
+	MetaTypeFloat64  = "float64"
+	MetaTypeTime     = "time"
+	MetaTypeDuration = "duration"
+	MetaTypeStrs     = "[]string"
+	MetaTypeInts     = "[]int"
+)
+
+// metaTypes returns type tags for the metadata values. The tags for groups
+// are objects with the tags for the group values. Values of types not
+// supported as metadata are skipped. Returns nil if there are no tags.
+func metaTypes(meta map[string]any) map[string]any {
+	var ret map[string]any
+	for key, value := range meta {
+		var tag any
+		if group, ok := value.(map[string]any); ok {
+			if tags := metaTypes(group); tags != nil {
+				tag = tags
+			}
+		} else if tt := metaType(value); tt != "" {
+			tag = tt
+		}
+		if tag == nil {
+			continue
+		}
+		if ret == nil {
+			ret = make(map[string]any, len(meta))
+		}
+		ret[key] = tag
+	}
+	return ret
+}
+
+// metaType returns the type tag for the metadata value. Returns an empty
+// string for the types not supported as metadata.
+func metaType(v any) string {
+	switch v.(type) {
+	case bool:
+		return MetaTypeBool
+	case string:
+		return MetaTypeString
+	case int:
+		return MetaTypeInt
+	case int64:
+		return MetaTypeInt64
+	case uint64:
+		return MetaTypeUint64
+	case float64:
+		return MetaTypeFloat64
+	case time.Time:
+		return MetaTypeTime
+	case time.Duration:
+		return MetaTypeDuration
+	case []string:
+		return MetaTypeStrs
+	case []int:
+		return MetaTypeInts
+	default:
+		return ""
+	}
+}
+
+// decodeMeta decodes JSON representation of metadata values using the type
+// tags. Values without a type tag or with an unknown tag are decoded the same
+// way as by [json.Unmarshal] to an "any" value. Values with object tags are
+// decoded as groups. Returns nil when raw is empty.
+func decodeMeta(raw, types map[string]json.RawMessage) (map[string]any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	meta := make(map[string]any, len(raw))
+	for key, data := range raw {
+		var tag string
+		tt := types[key]
+		if len(tt) > 0 && tt[0] == '{' {
+			group, err := decodeGroup(data, tt)
+			if err != nil {
+				return nil, err
+			}
+			meta[key] = group
+			continue
+		}
+		_ = json.Unmarshal(tt, &tag)
+		value, err := decodeMetaValue(tag, data)
+		if err != nil {
+			return nil, err
+		}
+		meta[key] = value
+	}
+	return meta, nil
+}
+
+// decodeGroup decodes JSON representation of the metadata group using the
+// object with the type tags for the group values.
+func decodeGroup(data, tags json.RawMessage) (map[string]any, error) {
+	var raw, types map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	_ = json.Unmarshal(tags, &types)
+	group, err := decodeMeta(raw, types)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		group = make(map[string]any)
+	}
+	return group, nil
+}
+
+// decodeMetaValue decodes JSON representation of the metadata value with
+// the given type tag.
+func decodeMetaValue(tag string, data json.RawMessage) (any, error) {
+	switch tag {
+	case MetaTypeBool:
+		return decodeAs[bool](data)
+	case MetaTypeString:
+		return decodeAs[string](data)
+	case MetaTypeInt:
+		return decodeAs[int](data)
+	case MetaTypeInt64:
+		return decodeAs[int64](data)
+	case MetaTypeUint64:
+		return decodeAs[uint64](data)
+	case MetaTypeFloat64:
+		return decodeAs[float64](data)
+	case MetaTypeTime:
+		return decodeAs[time.Time](data)
+	case MetaTypeDuration:
+		return decodeAs[time.Duration](data)
+	case MetaTypeStrs:
+		return decodeAs[[]string](data)
+	case MetaTypeInts:
+		return decodeAs[[]int](data)
+	default:
+		return decodeAs[any](data)
+	}
+}
+
+// decodeAs decodes JSON data to the value of type T.
+func decodeAs[T any](data json.RawMessage) (T, error) {
+	var v T
+	err := json.Unmarshal(data, &v)
+	return v, err
+}
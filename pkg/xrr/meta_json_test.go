@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_metaTypes(t *testing.T) {
+	t.Run("nil metadata", func(t *testing.T) {
+		// --- When ---
+		have := metaTypes(nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("all types", func(t *testing.T) {
+		// --- Given ---
+		meta := map[string]any{
+			"bool":     true,
+			"string":   "abc",
+			"int":      1,
+			"int64":    int64(2),
+			"float64":  3.0,
+			"time":     time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+			"duration": time.Second,
+			"uint64":   uint64(1),
+			"strs":     []string{"a"},
+			"ints":     []int{1},
+			"group":    map[string]any{"A": 1, "B": map[string]any{"C": "c"}},
+			"empty":    map[string]any{},
+			"other":    struct{}{},
+		}
+
+		// --- When ---
+		have := metaTypes(meta)
+
+		// --- Then ---
+		want := map[string]any{
+			"bool":     "bool",
+			"string":   "string",
+			"int":      "int",
+			"int64":    "int64",
+			"float64":  "float64",
+			"time":     "time",
+			"duration": "duration",
+			"uint64":   "uint64",
+			"strs":     "[]string",
+			"ints":     "[]int",
+			"group": map[string]any{
+				"A": "int",
+				"B": map[string]any{"C": "string"},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_metaType_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    any
+		want string
+	}{
+		{"bool", true, MetaTypeBool},
+		{"string", "abc", MetaTypeString},
+		{"int", 1, MetaTypeInt},
+		{"int64", int64(1), MetaTypeInt64},
+		{"float64", 1.0, MetaTypeFloat64},
+		{"time", time.Time{}, MetaTypeTime},
+		{"duration", time.Second, MetaTypeDuration},
+		{"uint64", uint64(1), MetaTypeUint64},
+		{"strings", []string{"a"}, MetaTypeStrs},
+		{"ints", []int{1}, MetaTypeInts},
+		{"group", map[string]any{}, ""},
+		{"not supported", int8(1), ""},
+		{"nil", nil, ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := metaType(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_decodeMeta(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have, err := decodeMeta(nil, nil)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("with types", func(t *testing.T) {
+		// --- Given ---
+		raw := map[string]json.RawMessage{
+			"int":      json.RawMessage(`1`),
+			"int64":    json.RawMessage(`9007199254740993`),
+			"time":     json.RawMessage(`"2000-01-02T03:04:05Z"`),
+			"duration": json.RawMessage(`1000000000`),
+			"untyped":  json.RawMessage(`2`),
+			"unknown":  json.RawMessage(`3`),
+		}
+		types := map[string]json.RawMessage{
+			"int":      json.RawMessage(`"int"`),
+			"int64":    json.RawMessage(`"int64"`),
+			"time":     json.RawMessage(`"time"`),
+			"duration": json.RawMessage(`"duration"`),
+			"unknown":  json.RawMessage(`"unknown"`),
+		}
+
+		// --- When ---
+		have, err := decodeMeta(raw, types)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{
+			"int":      1,
+			"int64":    int64(9007199254740993),
+			"time":     time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+			"duration": time.Second,
+			"untyped":  2.0,
+			"unknown":  3.0,
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("with groups", func(t *testing.T) {
+		// --- Given ---
+		raw := map[string]json.RawMessage{
+			"group": json.RawMessage(`{"A": 1, "B": {"C": 2}, "D": 3}`),
+			"empty": json.RawMessage(`{}`),
+		}
+		types := map[string]json.RawMessage{
+			"group": json.RawMessage(`{"A": "int", "B": {"C": "uint64"}}`),
+			"empty": json.RawMessage(`{}`),
+		}
+
+		// --- When ---
+		have, err := decodeMeta(raw, types)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		want := map[string]any{
+			"group": map[string]any{
+				"A": 1,
+				"B": map[string]any{"C": uint64(2)},
+				"D": 3.0,
+			},
+			"empty": map[string]any{},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("error - group value does not match the type", func(t *testing.T) {
+		// --- Given ---
+		raw := map[string]json.RawMessage{"group": json.RawMessage(`{"A": "a"}`)}
+		types := map[string]json.RawMessage{"group": json.RawMessage(`{"A": "int"}`)}
+
+		// --- When ---
+		have, err := decodeMeta(raw, types)
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - group is not an object", func(t *testing.T) {
+		// --- Given ---
+		raw := map[string]json.RawMessage{"group": json.RawMessage(`1`)}
+		types := map[string]json.RawMessage{"group": json.RawMessage(`{"A": "int"}`)}
+
+		// --- When ---
+		have, err := decodeMeta(raw, types)
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - value does not match the type", func(t *testing.T) {
+		// --- Given ---
+		raw := map[string]json.RawMessage{"int": json.RawMessage(`"abc"`)}
+		types := map[string]json.RawMessage{"int": json.RawMessage(`"int"`)}
+
+		// --- When ---
+		have, err := decodeMeta(raw, types)
+
+		// --- Then ---
+		var target *json.UnmarshalTypeError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_decodeMetaValue_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		tag  string
+		data string
+		want any
+	}{
+		{"bool", MetaTypeBool, `true`, true},
+		{"string", MetaTypeString, `"abc"`, "abc"},
+		{"int", MetaTypeInt, `1`, 1},
+		{"int64", MetaTypeInt64, `2`, int64(2)},
+		{"float64", MetaTypeFloat64, `3`, 3.0},
+		{
+			"time",
+			MetaTypeTime,
+			`"2000-01-02T03:04:05.000000006Z"`,
+			time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC),
+		},
+		{"duration", MetaTypeDuration, `1000`, time.Microsecond},
+		{"uint64", MetaTypeUint64, `18446744073709551615`, uint64(1<<64 - 1)},
+		{"strings", MetaTypeStrs, `["a", "b"]`, []string{"a", "b"}},
+		{"ints", MetaTypeInts, `[1, 2]`, []int{1, 2}},
+		{"no tag", "", `1`, 1.0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have, err := decodeMetaValue(tc.tag, json.RawMessage(tc.data))
+
+			// --- Then ---
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
@@ -115,6 +115,30 @@ func Test_Metadata_Int64(t *testing.T) {
 	})
 }
 
+func Test_Metadata_Uint64(t *testing.T) {
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Uint64("A", 1)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": uint64(1)}, have.m)
+	})
+
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{m: map[string]any{"A": 1}}
+
+		// --- When ---
+		have := m.Uint64("A", 2)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": uint64(2)}, have.m)
+	})
+}
+
 func Test_Metadata_Float64(t *testing.T) {
 	t.Run("not existing", func(t *testing.T) {
 		// --- Given ---
@@ -190,6 +214,151 @@ func Test_Metadata_Duration(t *testing.T) {
 	})
 }
 
+func Test_Metadata_Strs(t *testing.T) {
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Strs("A", "a", "b")
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": []string{"a", "b"}}, have.m)
+	})
+
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{m: map[string]any{"A": 1}}
+
+		// --- When ---
+		have := m.Strs("A", "a")
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": []string{"a"}}, have.m)
+	})
+
+	t.Run("slice is copied", func(t *testing.T) {
+		// --- Given ---
+		values := []string{"a", "b"}
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Strs("A", values...)
+
+		// --- Then ---
+		values[0] = "x"
+		assert.Equal(t, map[string]any{"A": []string{"a", "b"}}, have.m)
+	})
+}
+
+func Test_Metadata_Ints(t *testing.T) {
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Ints("A", 1, 2)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": []int{1, 2}}, have.m)
+	})
+
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{m: map[string]any{"A": 1}}
+
+		// --- When ---
+		have := m.Ints("A", 2)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": []int{2}}, have.m)
+	})
+
+	t.Run("slice is copied", func(t *testing.T) {
+		// --- Given ---
+		values := []int{1, 2}
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Ints("A", values...)
+
+		// --- Then ---
+		values[0] = 3
+		assert.Equal(t, map[string]any{"A": []int{1, 2}}, have.m)
+	})
+}
+
+func Test_Metadata_Group(t *testing.T) {
+	t.Run("not existing", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Group("A", Meta().Int("B", 1))
+
+		// --- Then ---
+		want := map[string]any{"A": map[string]any{"B": 1}}
+		assert.Equal(t, want, have.m)
+	})
+
+	t.Run("existing", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{m: map[string]any{"A": 1}}
+
+		// --- When ---
+		have := m.Group("A", Meta().Int("B", 2))
+
+		// --- Then ---
+		want := map[string]any{"A": map[string]any{"B": 2}}
+		assert.Equal(t, want, have.m)
+	})
+
+	t.Run("empty group", func(t *testing.T) {
+		// --- Given ---
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Group("A", Meta())
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": map[string]any{}}, have.m)
+	})
+
+	t.Run("group is copied", func(t *testing.T) {
+		// --- Given ---
+		grp := Meta().Int("B", 1)
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Group("A", grp)
+
+		// --- Then ---
+		grp.Int("B", 2)
+		want := map[string]any{"A": map[string]any{"B": 1}}
+		assert.Equal(t, want, have.m)
+	})
+
+	t.Run("nested values are copied", func(t *testing.T) {
+		// --- Given ---
+		grp := Meta().Ints("B", 1).Group("C", Meta().Strs("D", "d"))
+		m := Metadata{}
+
+		// --- When ---
+		have := m.Group("A", grp)
+
+		// --- Then ---
+		grp.m["B"].([]int)[0] = 2
+		grp.m["C"].(map[string]any)["D"].([]string)[0] = "x"
+		want := map[string]any{
+			"A": map[string]any{
+				"B": []int{1},
+				"C": map[string]any{"D": []string{"d"}},
+			},
+		}
+		assert.Equal(t, want, have.m)
+	})
+}
+
 func Test_Metadata_MetaSetAll(t *testing.T) {
 	t.Run("not existing", func(t *testing.T) {
 		// --- Given ---
@@ -11,6 +11,9 @@ type Options struct {
 	code string         // Error code.
 	meta map[string]any // Metadata associated with an error.
 	err  error          // Wrapped error.
+
+	// Stack trace sampling rate. Zero disables the stack recording.
+	stack int
 }
 
 // Set applies the provided options to the [Options] instance and returns it.
@@ -21,14 +24,21 @@ func (ops Options) Set(opts ...Option) Options {
 	return ops
 }
 
+// setMeta sets the metadata key to the value.
+func (ops *Options) setMeta(key string, value any) {
+	if ops.meta == nil {
+		ops.meta = make(map[string]any, 1)
+	}
+	ops.meta[key] = value
+}
+
 // WithCode is an option for setting the error code.
 func WithCode(code string) Option {
 	return func(ops *Options) { ops.code = code }
 }
 
-// WithMeta is an option for setting the metadata. The provided map must not be
-// modified or reused by the caller after passing it to this function. The
-// value types that are not supported will be skipped.
+// WithMeta is an option for setting the metadata. The value types that are
+// not supported will be skipped, slices and groups are deep copied.
 //
 // For supported metadata types see [MetaType] type constraint.
 func WithMeta(meta map[string]any) Option {
@@ -40,7 +50,7 @@ func WithMeta(meta map[string]any) Option {
 			if ops.meta == nil {
 				ops.meta = make(map[string]any, len(meta))
 			}
-			ops.meta[key] = value
+			ops.meta[key] = cloneMetaValue(value)
 		}
 	}
 }
@@ -70,3 +80,18 @@ func WithCause(cause error) Option {
 		ops.err = cause
 	}
 }
+
+// WithStack is an option for recording the call stack of the place where the
+// error is created. Use [GetStack] to retrieve it. Recording the stack has a
+// cost; for frequently executed code paths consider [WithStackSampled].
+func WithStack() Option {
+	return func(ops *Options) { ops.stack = 1 }
+}
+
+// WithStackSampled is an option for recording the call stack for one in every
+// n errors created at the same call site. The first error created at a given
+// call site always records its stack. Values of n lower than two are
+// equivalent to [WithStack].
+func WithStackSampled(n int) Option {
+	return func(ops *Options) { ops.stack = max(n, 1) }
+}
@@ -41,6 +41,30 @@ func Test_Options_Set(t *testing.T) {
 	})
 }
 
+func Test_Options_setMeta(t *testing.T) {
+	t.Run("nil metadata", func(t *testing.T) {
+		// --- Given ---
+		ops := &Options{}
+
+		// --- When ---
+		ops.setMeta("A", 1)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1}, ops.meta)
+	})
+
+	t.Run("existing metadata", func(t *testing.T) {
+		// --- Given ---
+		ops := &Options{meta: map[string]any{"A": 1}}
+
+		// --- When ---
+		ops.setMeta("B", 2)
+
+		// --- Then ---
+		assert.Equal(t, map[string]any{"A": 1, "B": 2}, ops.meta)
+	})
+}
+
 func Test_WithCode(t *testing.T) {
 	// --- Given ---
 	ops := &Options{}
@@ -108,6 +132,27 @@ func Test_WithMeta(t *testing.T) {
 		// --- Then ---
 		assert.Equal(t, map[string]any{"A": 1, "B": 3}, ops.meta)
 	})
+
+	t.Run("slices and groups are copied", func(t *testing.T) {
+		// --- Given ---
+		m := map[string]any{
+			"A": []string{"a"},
+			"B": map[string]any{"C": []int{1}},
+		}
+		ops := &Options{}
+
+		// --- When ---
+		WithMeta(m)(ops)
+
+		// --- Then ---
+		m["A"].([]string)[0] = "x"
+		m["B"].(map[string]any)["C"].([]int)[0] = 2
+		want := map[string]any{
+			"A": []string{"a"},
+			"B": map[string]any{"C": []int{1}},
+		}
+		assert.Equal(t, want, ops.meta)
+	})
 }
 
 func Test_WithCause(t *testing.T) {
@@ -180,3 +225,38 @@ func Test_WithMetaFrom(t *testing.T) {
 		assert.Equal(t, map[string]any{"A": 1}, ops.meta)
 	})
 }
+
+func Test_WithStack(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithStack()(ops)
+
+	// --- Then ---
+	assert.Equal(t, 1, ops.stack)
+}
+
+func Test_WithStackSampled(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		ops := &Options{}
+
+		// --- When ---
+		WithStackSampled(10)(ops)
+
+		// --- Then ---
+		assert.Equal(t, 10, ops.stack)
+	})
+
+	t.Run("values lower than one are the same as WithStack", func(t *testing.T) {
+		// --- Given ---
+		ops := &Options{}
+
+		// --- When ---
+		WithStackSampled(-1)(ops)
+
+		// --- Then ---
+		assert.Equal(t, 1, ops.stack)
+	})
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Registry validation error codes.
+const (
+	// ECCodeDuplicate represents error code indicating an error code was
+	// declared more than once in a [Registry].
+	ECCodeDuplicate = "ECCodeDuplicate"
+
+	// ECCodeUnknown represents error code indicating a sentinel error uses an
+	// error code which was not declared in a [Registry].
+	ECCodeUnknown = "ECCodeUnknown"
+)
+
+// registry is the default registry used by [Register], [RegisterSentinel],
+// [Lookup], [Codes] and [Validate].
+var registry = NewRegistry()
+
+// CodeInfo describes an error code.
+type CodeInfo struct {
+	Code        string // Error code.
+	Domain      string // Error domain, set when the code is registered.
+	Description string // Code description.
+	Message     string // Default error message.
+	Status      int    // HTTP status code, zero when not specified.
+	Retryable   bool   // True if the failed operation may be retried.
+	Public      bool   // True if the code may be exposed to clients.
+}
+
+// Registry represents a collection of error codes declared per error domain.
+// It is safe for concurrent use.
+type Registry struct {
+	codes     []CodeInfo // Declared codes in the declaration order.
+	sentinels []error    // Registered sentinel errors.
+	mx        sync.RWMutex
+}
+
+// NewRegistry returns a new empty instance of [Registry].
+func NewRegistry() *Registry { return &Registry{} }
+
+// DefaultRegistry returns the default [Registry] instance used by [Register],
+// [RegisterSentinel], [Lookup], [Codes] and [Validate].
+func DefaultRegistry() *Registry { return registry }
+
+// RegisterIn declares codes in domain T in the given registry. The
+// [CodeInfo.Domain] field is set to the name of domain T. Codes with empty
+// [CodeInfo.Code] are ignored. Duplicate declarations are recorded and
+// reported by [Registry.Validate].
+func RegisterIn[T Domain](reg *Registry, codes ...CodeInfo) {
+	reg.add(domainName[T](), codes...)
+}
+
+// CodesIn returns codes declared in domain T in the given registry in the
+// declaration order.
+func CodesIn[T Domain](reg *Registry) []CodeInfo {
+	return reg.domain(domainName[T]())
+}
+
+// Register declares codes in domain T in the default registry. See
+// [RegisterIn] for details.
+func Register[T Domain](codes ...CodeInfo) { RegisterIn[T](registry, codes...) }
+
+// RegisterSentinel adds sentinel errors to the default registry. See
+// [Registry.Sentinel] for details.
+func RegisterSentinel(errs ...error) { registry.Sentinel(errs...) }
+
+// Lookup returns the first declaration of the code in the default registry.
+// Returns false if the code was not declared.
+func Lookup(code string) (CodeInfo, bool) { return registry.Lookup(code) }
+
+// Codes returns codes declared in domain T in the default registry in the
+// declaration order.
+func Codes[T Domain]() []CodeInfo { return CodesIn[T](registry) }
+
+// Validate validates the default registry. See [Registry.Validate] for
+// details.
+func Validate() error { return registry.Validate() }
+
+// Sentinel adds sentinel errors to the registry. All codes used in sentinel
+// error trees must be declared in the registry, otherwise the
+// [Registry.Validate] will report them. Nil errors are ignored.
+func (reg *Registry) Sentinel(errs ...error) {
+	reg.mx.Lock()
+	defer reg.mx.Unlock()
+	for _, err := range errs {
+		if err != nil {
+			reg.sentinels = append(reg.sentinels, err)
+		}
+	}
+}
+
+// Lookup returns the first declaration of the code. Returns false if the
+// code was not declared.
+func (reg *Registry) Lookup(code string) (CodeInfo, bool) {
+	reg.mx.RLock()
+	defer reg.mx.RUnlock()
+	for _, info := range reg.codes {
+		if info.Code == code {
+			return info, true
+		}
+	}
+	return CodeInfo{}, false
+}
+
+// All returns all declared codes in the declaration order.
+func (reg *Registry) All() []CodeInfo {
+	reg.mx.RLock()
+	defer reg.mx.RUnlock()
+	return slices.Clone(reg.codes)
+}
+
+// Validate returns joined errors describing problems with the registry or nil
+// when there are none. It reports:
+//   - codes declared more than once, in the same or different domains, with
+//     the [ECCodeDuplicate] error code,
+//   - codes used by sentinel errors which were never declared, with the
+//     [ECCodeUnknown] error code.
+//
+// Each reported error has the "code" metadata key set to the offending code.
+func (reg *Registry) Validate() error {
+	reg.mx.RLock()
+	defer reg.mx.RUnlock()
+
+	var ers []error
+	domains := make(map[string][]string, len(reg.codes))
+	var order []string
+	for _, info := range reg.codes {
+		if _, ok := domains[info.Code]; !ok {
+			order = append(order, info.Code)
+		}
+		domains[info.Code] = append(domains[info.Code], info.Domain)
+	}
+	for _, code := range order {
+		if ds := domains[code]; len(ds) > 1 {
+			msg := fmt.Sprintf(
+				"error code %q declared more than once in domains: %s",
+				code,
+				strings.Join(ds, ", "),
+			)
+			meta := Meta().Str("code", code).Option()
+			ers = append(ers, New(msg, ECCodeDuplicate, meta))
+		}
+	}
+
+	reported := make(map[string]struct{})
+	for _, err := range reg.sentinels {
+		for _, code := range GetCodes(err) {
+			if _, ok := domains[code]; ok {
+				continue
+			}
+			if _, ok := reported[code]; ok {
+				continue
+			}
+			reported[code] = struct{}{}
+			msg := fmt.Sprintf(
+				"error code %q used by sentinel error %q not declared",
+				code,
+				err.Error(),
+			)
+			meta := Meta().Str("code", code).Option()
+			ers = append(ers, New(msg, ECCodeUnknown, meta))
+		}
+	}
+	return Join(ers...)
+}
+
+// add declares codes in the named domain.
+func (reg *Registry) add(domain string, codes ...CodeInfo) {
+	reg.mx.Lock()
+	defer reg.mx.Unlock()
+	for _, info := range codes {
+		if info.Code == "" {
+			continue
+		}
+		info.Domain = domain
+		reg.codes = append(reg.codes, info)
+	}
+}
+
+// domain returns codes declared in the named domain.
+func (reg *Registry) domain(domain string) []CodeInfo {
+	reg.mx.RLock()
+	defer reg.mx.RUnlock()
+	var ret []CodeInfo
+	for _, info := range reg.codes {
+		if info.Domain == domain {
+			ret = append(ret, info)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+// edOther is the marker type for the test error domain.
+type edOther struct{}
+
+func Test_NewRegistry(t *testing.T) {
+	// --- When ---
+	have := NewRegistry()
+
+	// --- Then ---
+	assert.NotNil(t, have)
+	assert.Nil(t, have.All())
+	assert.NoError(t, have.Validate())
+}
+
+func Test_DefaultRegistry(t *testing.T) {
+	// --- When ---
+	have := DefaultRegistry()
+
+	// --- Then ---
+	assert.Same(t, registry, have)
+}
+
+func Test_RegisterIn(t *testing.T) {
+	t.Run("set domain", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+
+		// --- When ---
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0", Domain: "other"})
+
+		// --- Then ---
+		want := []CodeInfo{{Code: "EC0", Domain: "xrr.EDXrr"}}
+		assert.Equal(t, want, reg.All())
+	})
+
+	t.Run("codes without code are ignored", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+
+		// --- When ---
+		RegisterIn[EDXrr](reg, CodeInfo{Description: "abc"})
+
+		// --- Then ---
+		assert.Nil(t, reg.All())
+	})
+}
+
+func Test_CodesIn(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry()
+	RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0"}, CodeInfo{Code: "EC1"})
+	RegisterIn[edOther](reg, CodeInfo{Code: "EC2"})
+
+	// --- When ---
+	have := CodesIn[EDXrr](reg)
+
+	// --- Then ---
+	want := []CodeInfo{
+		{Code: "EC0", Domain: "xrr.EDXrr"},
+		{Code: "EC1", Domain: "xrr.EDXrr"},
+	}
+	assert.Equal(t, want, have)
+}
+
+func Test_Lookup(t *testing.T) {
+	t.Run("package codes are declared", func(t *testing.T) {
+		// --- When ---
+		have, ok := Lookup(ECFields)
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, ECFields, have.Code)
+		assert.Equal(t, "xrr.EDXrr", have.Domain)
+		assert.Equal(t, "fields error", have.Message)
+		assert.Equal(t, 422, have.Status)
+		assert.True(t, have.Public)
+	})
+
+	t.Run("not declared", func(t *testing.T) {
+		// --- When ---
+		have, ok := Lookup("ECUnknown")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Zero(t, have)
+	})
+}
+
+func Test_Codes(t *testing.T) {
+	// --- When ---
+	have := Codes[EDXrr]()
+
+	// --- Then ---
+	assert.Len(t, 6, have)
+	assert.Equal(t, ECGeneric, have[0].Code)
+}
+
+func Test_Validate(t *testing.T) {
+	// --- When ---
+	err := Validate()
+
+	// --- Then ---
+	assert.NoError(t, err)
+}
+
+func Test_Registry_Sentinel(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry()
+
+	// --- When ---
+	reg.Sentinel(ErrTst, nil, ErrFields)
+
+	// --- Then ---
+	assert.Equal(t, []error{ErrTst, ErrFields}, reg.sentinels)
+}
+
+func Test_Registry_Lookup(t *testing.T) {
+	t.Run("first declaration is returned", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0", Status: 400})
+		RegisterIn[edOther](reg, CodeInfo{Code: "EC0", Status: 500})
+
+		// --- When ---
+		have, ok := reg.Lookup("EC0")
+
+		// --- Then ---
+		assert.True(t, ok)
+		want := CodeInfo{Code: "EC0", Domain: "xrr.EDXrr", Status: 400}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("not declared", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+
+		// --- When ---
+		have, ok := reg.Lookup("EC0")
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Zero(t, have)
+	})
+}
+
+func Test_Registry_All(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry()
+	RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0"})
+	RegisterIn[edOther](reg, CodeInfo{Code: "EC1"})
+
+	// --- When ---
+	have := reg.All()
+
+	// --- Then ---
+	want := []CodeInfo{
+		{Code: "EC0", Domain: "xrr.EDXrr"},
+		{Code: "EC1", Domain: "xrr.edOther"},
+	}
+	assert.Equal(t, want, have)
+}
+
+func Test_Registry_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0"}, CodeInfo{Code: "EC1"})
+		reg.Sentinel(New("msg", "EC0"))
+
+		// --- When ---
+		err := reg.Validate()
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("duplicate codes", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0"}, CodeInfo{Code: "EC1"})
+		RegisterIn[edOther](reg, CodeInfo{Code: "EC0"})
+
+		// --- When ---
+		err := reg.Validate()
+
+		// --- Then ---
+		wMsg := "error code \"EC0\" declared more than once in domains: " +
+			"xrr.EDXrr, xrr.edOther"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Equal(t, ECCodeDuplicate, GetCode(err))
+		have, _ := GetStr(err, "code")
+		assert.Equal(t, "EC0", have)
+	})
+
+	t.Run("duplicate codes in the same domain", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0"}, CodeInfo{Code: "EC0"})
+
+		// --- When ---
+		err := reg.Validate()
+
+		// --- Then ---
+		wMsg := "error code \"EC0\" declared more than once in domains: " +
+			"xrr.EDXrr, xrr.EDXrr"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("sentinel with not declared code", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0"})
+		reg.Sentinel(
+			New("msg 0", "EC0"),
+			New("msg 1", "EC1"),
+			Wrap(New("msg 2", "EC1"), WithCode("EC0")),
+		)
+
+		// --- When ---
+		err := reg.Validate()
+
+		// --- Then ---
+		wMsg := "error code \"EC1\" used by sentinel error \"msg 1\" not declared"
+		assert.ErrorEqual(t, wMsg, err)
+		assert.Equal(t, ECCodeUnknown, GetCode(err))
+		have, _ := GetStr(err, "code")
+		assert.Equal(t, "EC1", have)
+	})
+
+	t.Run("sentinel without code", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		reg.Sentinel(errors.New("msg"))
+
+		// --- When ---
+		err := reg.Validate()
+
+		// --- Then ---
+		wMsg := "error code \"ECGeneric\" used by sentinel error \"msg\" " +
+			"not declared"
+		assert.ErrorEqual(t, wMsg, err)
+	})
+
+	t.Run("multiple problems", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0"})
+		RegisterIn[edOther](reg, CodeInfo{Code: "EC0"})
+		reg.Sentinel(New("msg", "EC1"))
+
+		// --- When ---
+		err := reg.Validate()
+
+		// --- Then ---
+		assert.True(t, IsJoined(err))
+		assert.Equal(t, []string{ECCodeDuplicate, ECCodeUnknown}, GetCodes(err))
+	})
+}
+
+func Test_RegisterIn_named_domain(t *testing.T) {
+	// --- Given ---
+	reg := NewRegistry()
+
+	// --- When ---
+	RegisterIn[edNamed](reg, CodeInfo{Code: "EC0"})
+
+	// --- Then ---
+	assert.Equal(t, []CodeInfo{{Code: "EC0", Domain: "named"}}, reg.All())
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// Metadata keys used for retry classification and by [Retry]. Like any
+// other metadata, they are included in the JSON representation of the errors.
+const (
+	// MetaRetryable is the metadata key set by [WithRetryable].
+	MetaRetryable = "retryable"
+
+	// MetaRetryAfter is the metadata key set by [WithRetryAfter].
+	MetaRetryAfter = "retry_after"
+
+	// MetaAttempts is the metadata key with the number of attempts made by
+	// [Retry].
+	MetaAttempts = "attempts"
+
+	// MetaElapsed is the metadata key with the total time spent by [Retry].
+	MetaElapsed = "elapsed"
+)
+
+// Default [RetryPolicy] values.
+const (
+	defRetryAttempts   = 3
+	defRetryDelay      = 100 * time.Millisecond
+	defRetryMultiplier = 2
+)
+
+// WithRetryable is an option marking the error as retryable or not. It takes
+// precedence over the [CodeInfo.Retryable] value of the error codes. See
+// [IsRetryable].
+func WithRetryable(retryable bool) Option {
+	return func(ops *Options) { ops.setMeta(MetaRetryable, retryable) }
+}
+
+// WithRetryAfter is an option setting the minimum time to wait before the
+// failed operation is retried. See [RetryAfter].
+func WithRetryAfter(after time.Duration) Option {
+	return func(ops *Options) { ops.setMeta(MetaRetryAfter, after) }
+}
+
+// IsRetryable returns true if the failed operation which returned err may be
+// retried. The error chain (tree) is walked in the same order as in
+// [GetCodes] and the first of the following rules which applies decides:
+//   - the first error with the [MetaRetryable] metadata key (see
+//     [WithRetryable]),
+//   - the [CodeInfo.Retryable] value of the first error implementing [Coder]
+//     with the code declared in the default registry (see [Register]); the
+//     [ECGeneric] code is skipped, since it marks errors without a code.
+//
+// Returns false when none of the rules apply.
+func IsRetryable(err error) bool { return isRetryable(registry, err) }
+
+// isRetryable implements [IsRetryable] using the given registry.
+func isRetryable(reg *Registry, err error) bool {
+	if retryable, ok := GetBool(err, MetaRetryable); ok {
+		return retryable
+	}
+	var retryable bool
+	cb := func(err error) bool {
+		e, ok := err.(Coder)
+		if !ok || e.ErrorCode() == ECGeneric {
+			return true
+		}
+		info, ok := reg.Lookup(e.ErrorCode())
+		if !ok {
+			return true
+		}
+		retryable = info.Retryable
+		return false
+	}
+	walk(err, cb)
+	return retryable
+}
+
+// RetryAfter returns the first [MetaRetryAfter] metadata value found in the
+// error chain (tree). See [WithRetryAfter].
+func RetryAfter(err error) (time.Duration, bool) {
+	return GetDuration(err, MetaRetryAfter)
+}
+
+// RetryPolicy represents the policy used by [Retry].
+type RetryPolicy struct {
+	// Maximum number of attempts. Values lower than one default to three.
+	MaxAttempts int
+
+	// Delay before the second attempt. Values lower than one default to
+	// 100 milliseconds.
+	BaseDelay time.Duration
+
+	// Maximum delay between attempts. Values lower than one mean no limit.
+	MaxDelay time.Duration
+
+	// Delay multiplier applied after each attempt. Values lower than one
+	// default to two.
+	Multiplier float64
+
+	// Fraction of the delay, between zero and one, which is randomized.
+	// For example, with jitter 0.2, the delay of one second becomes a random
+	// delay between 0.8 and 1 second.
+	Jitter float64
+}
+
+// delay returns the delay before the next attempt. The n is the number of
+// attempts made so far.
+func (pol RetryPolicy) delay(n int) time.Duration {
+	d := float64(pol.BaseDelay)
+	for range n - 1 {
+		d *= pol.Multiplier
+		if pol.MaxDelay > 0 && d > float64(pol.MaxDelay) {
+			break
+		}
+	}
+	if pol.MaxDelay > 0 {
+		d = min(d, float64(pol.MaxDelay))
+	}
+	if jitter := min(max(pol.Jitter, 0), 1); jitter > 0 {
+		d -= d * jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// Retry calls fn until it returns nil, returns a non-retryable error (see
+// [IsRetryable]), the maximum number of attempts is reached or the context is
+// done. Between the attempts it waits for the exponentially growing delay
+// defined by the policy, or for the [RetryAfter] duration of the error when
+// it is longer.
+//
+// Returns nil when fn succeeds. Otherwise, returns [GenericError] wrapping
+// the last error (joined with the context error when the context is done)
+// with the last error code and the [MetaAttempts] and [MetaElapsed]
+// metadata.
+func Retry(ctx context.Context, pol RetryPolicy, fn func(context.Context) error) error {
+	if pol.MaxAttempts < 1 {
+		pol.MaxAttempts = defRetryAttempts
+	}
+	if pol.BaseDelay < 1 {
+		pol.BaseDelay = defRetryDelay
+	}
+	if pol.Multiplier < 1 {
+		pol.Multiplier = defRetryMultiplier
+	}
+
+	start := time.Now()
+	var err, last error
+	var n int
+	for {
+		if ctx.Err() != nil {
+			err = Join(err, ctx.Err())
+			break
+		}
+		n++
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+		last = err
+		if n >= pol.MaxAttempts || !IsRetryable(err) {
+			break
+		}
+
+		delay := pol.delay(n)
+		if after, ok := RetryAfter(err); ok && after > delay {
+			delay = after
+		}
+		if !sleep(ctx, delay) {
+			err = Join(err, ctx.Err())
+			break
+		}
+	}
+
+	meta := Meta().Int(MetaAttempts, n).Duration(MetaElapsed, time.Since(start))
+	code := GetCode(err)
+	if last != nil {
+		code = GetCode(last)
+	}
+	return &GenericError[EDXrr]{code: code, meta: meta.m, err: err}
+}
+
+// sleep waits for the duration or until the context is done. Returns false
+// when the context is done.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,359 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_WithRetryable(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithRetryable(true)(ops)
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{MetaRetryable: true}, ops.meta)
+}
+
+func Test_WithRetryAfter(t *testing.T) {
+	// --- Given ---
+	ops := &Options{}
+
+	// --- When ---
+	WithRetryAfter(time.Second)(ops)
+
+	// --- Then ---
+	assert.Equal(t, map[string]any{MetaRetryAfter: time.Second}, ops.meta)
+}
+
+func Test_IsRetryable(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have := IsRetryable(nil)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("standard error", func(t *testing.T) {
+		// --- When ---
+		have := IsRetryable(errors.New("msg"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("retryable", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode", WithRetryable(true))
+
+		// --- When ---
+		have := IsRetryable(e)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("outer error takes precedence", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode", WithRetryable(true))
+		e = Wrap(e, WithRetryable(false))
+
+		// --- When ---
+		have := IsRetryable(e)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("wrapped retryable", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode", WithRetryable(true))
+		e = fmt.Errorf("wrap: %w", e)
+
+		// --- When ---
+		have := IsRetryable(e)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+}
+
+func Test_isRetryable(t *testing.T) {
+	t.Run("registered code", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0", Retryable: true})
+
+		// --- When ---
+		have := isRetryable(reg, fmt.Errorf("wrap: %w", New("msg", "EC0")))
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("first registered code", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](
+			reg,
+			CodeInfo{Code: "EC1"},
+			CodeInfo{Code: "EC2", Retryable: true},
+		)
+		e := New("m0", "EC0", WithCause(New("m1", "EC1", WithCause(New("m2", "EC2")))))
+
+		// --- When ---
+		have := isRetryable(reg, e)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("generic code is skipped", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](
+			reg,
+			CodeInfo{Code: ECGeneric},
+			CodeInfo{Code: "EC0", Retryable: true},
+		)
+		e := New("wrap", ECGeneric, WithCause(New("msg", "EC0")))
+
+		// --- When ---
+		have := isRetryable(reg, e)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("metadata takes precedence over registry", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+		RegisterIn[EDXrr](reg, CodeInfo{Code: "EC0", Retryable: true})
+		e := Wrap(New("msg", "EC0", WithRetryable(false)))
+
+		// --- When ---
+		have := isRetryable(reg, e)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("not registered code", func(t *testing.T) {
+		// --- Given ---
+		reg := NewRegistry()
+
+		// --- When ---
+		have := isRetryable(reg, New("msg", "EC0"))
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_RetryAfter(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		// --- Given ---
+		e := Wrap(New("msg", "ECode", WithRetryAfter(time.Second)))
+
+		// --- When ---
+		have, ok := RetryAfter(e)
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, have)
+	})
+
+	t.Run("not set", func(t *testing.T) {
+		// --- When ---
+		have, ok := RetryAfter(New("msg", "ECode"))
+
+		// --- Then ---
+		assert.False(t, ok)
+		assert.Equal(t, time.Duration(0), have)
+	})
+}
+
+func Test_RetryPolicy_delay_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pol  RetryPolicy
+		n    int
+		want time.Duration
+	}{
+		{"first", RetryPolicy{BaseDelay: 10, Multiplier: 2}, 1, 10},
+		{"second", RetryPolicy{BaseDelay: 10, Multiplier: 2}, 2, 20},
+		{"third", RetryPolicy{BaseDelay: 10, Multiplier: 2}, 3, 40},
+		{"max delay", RetryPolicy{BaseDelay: 10, Multiplier: 2, MaxDelay: 30}, 3, 30},
+		{"max delay many attempts", RetryPolicy{BaseDelay: 10, Multiplier: 2, MaxDelay: 30}, 1000, 30},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := tc.pol.delay(tc.n)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_RetryPolicy_delay_jitter(t *testing.T) {
+	// --- Given ---
+	pol := RetryPolicy{BaseDelay: 1000, Multiplier: 2, Jitter: 0.2}
+
+	for range 100 {
+		// --- When ---
+		have := pol.delay(1)
+
+		// --- Then ---
+		assert.GreaterOrEqual(t, time.Duration(800), have)
+		assert.SmallerOrEqual(t, time.Duration(1000), have)
+	}
+}
+
+func Test_Retry(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		fn := func(context.Context) error {
+			calls++
+			if calls < 3 {
+				return New("msg", "ECode", WithRetryable(true))
+			}
+			return nil
+		}
+
+		// --- When ---
+		err := Retry(t.Context(), RetryPolicy{BaseDelay: time.Nanosecond}, fn)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		e := New("msg", "ECode", WithRetryable(true))
+		fn := func(context.Context) error { calls++; return e }
+		pol := RetryPolicy{MaxAttempts: 4, BaseDelay: time.Nanosecond}
+
+		// --- When ---
+		err := Retry(t.Context(), pol, fn)
+
+		// --- Then ---
+		assert.Equal(t, 4, calls)
+		assert.ErrorIs(t, e, err)
+		assert.Equal(t, "msg", err.Error())
+		assert.Equal(t, "ECode", GetCode(err))
+		assert.SameType(t, &GenericError[EDXrr]{}, err)
+
+		attempts, _ := GetInt(err, MetaAttempts)
+		assert.Equal(t, 4, attempts)
+		_, ok := GetDuration(err, MetaElapsed)
+		assert.True(t, ok)
+	})
+
+	t.Run("not retryable error", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		e := New("msg", "ECode")
+		fn := func(context.Context) error { calls++; return e }
+
+		// --- When ---
+		err := Retry(t.Context(), RetryPolicy{BaseDelay: time.Nanosecond}, fn)
+
+		// --- Then ---
+		assert.Equal(t, 1, calls)
+		assert.ErrorIs(t, e, err)
+		attempts, _ := GetInt(err, MetaAttempts)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("default max attempts", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		fn := func(context.Context) error {
+			calls++
+			return New("msg", "ECode", WithRetryable(true))
+		}
+
+		// --- When ---
+		err := Retry(t.Context(), RetryPolicy{BaseDelay: time.Nanosecond}, fn)
+
+		// --- Then ---
+		assert.Error(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("honors retry after", func(t *testing.T) {
+		// --- Given ---
+		var calls int
+		fn := func(context.Context) error {
+			calls++
+			return New(
+				"msg",
+				"ECode",
+				WithRetryable(true),
+				WithRetryAfter(20*time.Millisecond),
+			)
+		}
+		pol := RetryPolicy{MaxAttempts: 2, BaseDelay: time.Nanosecond}
+
+		// --- When ---
+		err := Retry(t.Context(), pol, fn)
+
+		// --- Then ---
+		assert.Equal(t, 2, calls)
+		elapsed, _ := GetDuration(err, MetaElapsed)
+		assert.GreaterOrEqual(t, 20*time.Millisecond, elapsed)
+	})
+
+	t.Run("context done while waiting", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithCancel(t.Context())
+		var calls int
+		e := New("msg", "ECode", WithRetryable(true))
+		fn := func(context.Context) error { calls++; cancel(); return e }
+
+		// --- When ---
+		err := Retry(ctx, RetryPolicy{BaseDelay: time.Hour}, fn)
+
+		// --- Then ---
+		assert.Equal(t, 1, calls)
+		assert.ErrorIs(t, e, err)
+		assert.ErrorIs(t, context.Canceled, err)
+		assert.Equal(t, "ECode", GetCode(err))
+		attempts, _ := GetInt(err, MetaAttempts)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("context done before first attempt", func(t *testing.T) {
+		// --- Given ---
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		var calls int
+		fn := func(context.Context) error { calls++; return nil }
+
+		// --- When ---
+		err := Retry(ctx, RetryPolicy{}, fn)
+
+		// --- Then ---
+		assert.Equal(t, 0, calls)
+		assert.ErrorIs(t, context.Canceled, err)
+		attempts, _ := GetInt(err, MetaAttempts)
+		assert.Equal(t, 0, attempts)
+	})
+}
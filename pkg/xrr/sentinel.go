@@ -29,3 +29,43 @@ var (
 	// implements [Fielder] and no explicit lead error is provided.
 	ErrFields = New("fields error", ECFields)
 )
+
+func init() {
+	Register[EDXrr](
+		CodeInfo{
+			Code:        ECGeneric,
+			Description: "Error without an assigned error code.",
+			Status:      500, // Internal Server Error.
+		},
+		CodeInfo{
+			Code:        ECInvJSON,
+			Description: "JSON structure or format error.",
+			Message:     ErrInvJSON.Error(),
+			Status:      400, // Bad Request.
+			Public:      true,
+		},
+		CodeInfo{
+			Code:        ECInvJSONError,
+			Description: "JSON is not a valid error representation.",
+			Message:     ErrInvJSONError.Error(),
+			Status:      400, // Bad Request.
+			Public:      true,
+		},
+		CodeInfo{
+			Code:        ECFields,
+			Description: "One or more fields have errors.",
+			Message:     ErrFields.Error(),
+			Status:      422, // Unprocessable Entity.
+			Public:      true,
+		},
+		CodeInfo{
+			Code:        ECCodeDuplicate,
+			Description: "Error code declared more than once.",
+		},
+		CodeInfo{
+			Code:        ECCodeUnknown,
+			Description: "Sentinel error code not declared.",
+		},
+	)
+	RegisterSentinel(ErrInvJSON, ErrInvJSONError, ErrFields)
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// maxStackDepth is the maximum number of frames recorded in a [Stack].
+const maxStackDepth = 32
+
+// stackSamples holds per call site counters used by [WithStackSampled]. The
+// keys are program counters of the call sites and values are pointers to
+// [atomic.Uint64] counters.
+var stackSamples sync.Map
+
+// Stack represents program counters of the call stack recorded when an error
+// was created. See [WithStack] and [WithStackSampled].
+type Stack []uintptr
+
+// Frames returns the stack frames.
+func (s Stack) Frames() []runtime.Frame {
+	if len(s) == 0 {
+		return nil
+	}
+	var ret []runtime.Frame
+	frames := runtime.CallersFrames(s)
+	for {
+		frame, more := frames.Next()
+		ret = append(ret, frame)
+		if !more {
+			break
+		}
+	}
+	return ret
+}
+
+// String returns the stack frames, one function per line followed by the
+// tab-indented file and line on the next line.
+func (s Stack) String() string {
+	var b strings.Builder
+	for i, frame := range s.Frames() {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(frame.Function)
+		b.WriteString("\n\t")
+		b.WriteString(frame.File)
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(frame.Line))
+	}
+	return b.String()
+}
+
+// MarshalJSON marshals the stack as an array of frame objects with "func",
+// "file" and "line" keys.
+func (s Stack) MarshalJSON() ([]byte, error) {
+	frames := s.Frames()
+	ret := make([]map[string]any, len(frames))
+	for i, frame := range frames {
+		ret[i] = map[string]any{
+			"func": frame.Function,
+			"file": frame.File,
+			"line": frame.Line,
+		}
+	}
+	return json.Marshal(ret)
+}
+
+// GetStack returns the stack recorded by the error deepest in the chain
+// (tree). The tree is traversed in the same order as in [GetMeta], and the
+// first recorded stack found is returned. Returns nil when none of the errors
+// recorded a stack.
+func GetStack(err error) Stack {
+	var stack Stack
+	cb := func(err error) bool {
+		if e, ok := err.(Stacker); ok {
+			if s := e.ErrorStack(); len(s) > 0 {
+				stack = s
+				return false
+			}
+		}
+		return true
+	}
+	walkReverse(err, cb)
+	return stack
+}
+
+// callers returns the call stack starting at the caller of the function
+// calling it, skipping additional skip frames. The rate is the sampling rate
+// as set by [WithStack] and [WithStackSampled]. Returns nil when the rate is
+// zero or when the call site was not selected by the sampling.
+func callers(skip, rate int) Stack {
+	if rate <= 0 {
+		return nil
+	}
+	var pcs [maxStackDepth]uintptr
+
+	// Skip runtime.Callers, this function and its caller.
+	skip += 3
+	if rate > 1 {
+		if runtime.Callers(skip, pcs[:1]) == 0 {
+			return nil
+		}
+		cnt, _ := stackSamples.LoadOrStore(pcs[0], new(atomic.Uint64))
+		if cnt.(*atomic.Uint64).Add(1)%uint64(rate) != 1 { // nolint: gosec
+			return nil
+		}
+	}
+	n := runtime.Callers(skip, pcs[:])
+	return slices.Clone(Stack(pcs[:n]))
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_Stack_Frames(t *testing.T) {
+	t.Run("nil stack", func(t *testing.T) {
+		// --- Given ---
+		var s Stack
+
+		// --- When ---
+		have := s.Frames()
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("frames", func(t *testing.T) {
+		// --- Given ---
+		s := GetStack(New("msg", "ECode", WithStack()))
+
+		// --- When ---
+		have := s.Frames()
+
+		// --- Then ---
+		assert.True(t, len(have) > 1)
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_Stack_Frames.func2"
+		assert.Equal(t, wFn, have[0].Function)
+		assert.True(t, strings.HasSuffix(have[0].File, "stack_test.go"))
+	})
+}
+
+func Test_Stack_String(t *testing.T) {
+	t.Run("nil stack", func(t *testing.T) {
+		// --- Given ---
+		var s Stack
+
+		// --- When ---
+		have := s.String()
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("frames", func(t *testing.T) {
+		// --- Given ---
+		s := GetStack(New("msg", "ECode", WithStack()))
+
+		// --- When ---
+		have := s.String()
+
+		// --- Then ---
+		lines := strings.Split(have, "\n")
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_Stack_String.func2"
+		assert.Equal(t, wFn, lines[0])
+		assert.True(t, strings.HasPrefix(lines[1], "\t"))
+		assert.True(t, strings.Contains(lines[1], "stack_test.go:"))
+	})
+}
+
+func Test_Stack_MarshalJSON(t *testing.T) {
+	// --- Given ---
+	s := GetStack(New("msg", "ECode", WithStack()))
+
+	// --- When ---
+	data, err := json.Marshal(s)
+
+	// --- Then ---
+	assert.NoError(t, err)
+	var have []map[string]any
+	assert.NoError(t, json.Unmarshal(data, &have))
+	assert.Len(t, len(s.Frames()), have)
+	assert.Equal(t, "github.com/ctx42/xrr/pkg/xrr.Test_Stack_MarshalJSON", have[0]["func"])
+	assert.HasKey(t, "file", have[0])
+	assert.HasKey(t, "line", have[0])
+}
+
+func Test_GetStack(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have := GetStack(nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("no stack recorded", func(t *testing.T) {
+		// --- Given ---
+		e := Wrap(New("msg", "ECode"))
+
+		// --- When ---
+		have := GetStack(e)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("the deepest stack is returned", func(t *testing.T) {
+		// --- Given ---
+		e0 := New("msg", "ECode", WithStack())
+		e1 := Wrap(e0, WithStack())
+		e2 := fmt.Errorf("wrap: %w", e1)
+
+		// --- When ---
+		have := GetStack(e2)
+
+		// --- Then ---
+		assert.Equal(t, e0.(*Error).stack, have) // nolint: errorlint
+	})
+
+	t.Run("joined errors", func(t *testing.T) {
+		// --- Given ---
+		e0 := New("msg 0", "ECode0")
+		e1 := New("msg 1", "ECode1", WithStack())
+
+		// --- When ---
+		have := GetStack(errors.Join(e0, e1))
+
+		// --- Then ---
+		assert.Equal(t, e1.(*Error).stack, have) // nolint: errorlint
+	})
+}
+
+func Test_callers(t *testing.T) {
+	t.Run("zero rate", func(t *testing.T) {
+		// --- When ---
+		have := callers(0, 0)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("New records its caller", func(t *testing.T) {
+		// --- When ---
+		e := New("msg", "ECode", WithStack())
+
+		// --- Then ---
+		frames := GetStack(e).Frames()
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_callers.func2"
+		assert.Equal(t, wFn, frames[0].Function)
+	})
+
+	t.Run("ErrorFunc constructor records its caller", func(t *testing.T) {
+		// --- Given ---
+		fn := ErrorFunc[EDXrr]()
+
+		// --- When ---
+		e := fn("msg", "ECode", WithStack())
+
+		// --- Then ---
+		frames := GetStack(e).Frames()
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_callers.func3"
+		assert.Equal(t, wFn, frames[0].Function)
+	})
+
+	t.Run("Wrap and WrapUsing record their caller", func(t *testing.T) {
+		// --- When ---
+		e0 := Wrap(ErrTst, WithStack())
+		e1 := WrapUsing[EDXrr](ErrTst, WithStack())
+
+		// --- Then ---
+		wFn := "github.com/ctx42/xrr/pkg/xrr.Test_callers.func4"
+		assert.Equal(t, wFn, GetStack(e0).Frames()[0].Function)
+		assert.Equal(t, wFn, GetStack(e1).Frames()[0].Function)
+	})
+
+	t.Run("sampled per call site", func(t *testing.T) {
+		// --- Given ---
+		var recorded int
+
+		// --- When ---
+		for range 10 {
+			e := New("msg", "ECode", WithStackSampled(5))
+			if GetStack(e) != nil {
+				recorded++
+			}
+		}
+
+		// --- Then ---
+		assert.Equal(t, 2, recorded)
+	})
+}
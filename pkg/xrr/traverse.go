@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"iter"
+	"slices"
+)
+
+// StepKind represents the kind of the [Step] in the error chain (tree).
+type StepKind int
+
+// Step kinds.
+const (
+	// StepWrap represents a step from an error to the error it wraps.
+	StepWrap StepKind = iota
+
+	// StepJoin represents a step from joined errors to one of them.
+	StepJoin
+
+	// StepField represents a step from a [Fielder] error to one of its
+	// field errors.
+	StepField
+)
+
+// Step represents a single step on the way from the root of the error chain
+// (tree) to the [Node].
+type Step struct {
+	Kind  StepKind // Step kind.
+	Field string   // Field name for the StepField kind.
+	Index int      // Joined error index for the StepJoin kind.
+}
+
+// Node represents an error visited when traversing the error chain (tree).
+type Node struct {
+	// The visited error.
+	Err error
+
+	// Number of visited errors between the root and the node. Joined errors
+	// and [Fielder] errors not implementing [Coder] are not visited, so they
+	// do not increase the depth.
+	Depth int
+
+	// Steps from the root to the node. Empty for the root error.
+	Path []Step
+
+	// Error code as returned by [GetCode].
+	Code string
+
+	// Metadata held directly by the error (see [Metadater]), nil if none.
+	Meta map[string]any
+}
+
+// All returns an iterator over errors in the error chain (tree). Errors are
+// visited in the same order as in [IsCode] and [GetCodes], joined errors and
+// [Fielder] errors not implementing [Coder] are transparent containers, and
+// the field errors are visited in the field name order.
+func All(err error) iter.Seq[error] {
+	return func(yield func(error) bool) { walk(err, yield) }
+}
+
+// Nodes returns an iterator over the nodes of the error chain (tree). The
+// errors are visited in the same order as in [All].
+func Nodes(err error) iter.Seq[Node] {
+	return func(yield func(Node) bool) { walkNodes(err, 0, nil, yield) }
+}
+
+// SplitAll works like [Split] but recursively splits the joined errors until
+// none of the returned errors is joined. It will return nil if the error is
+// nil.
+func SplitAll(err error) []error {
+	if err == nil {
+		return nil
+	}
+	es, ok := err.(joined)
+	if !ok {
+		return []error{err}
+	}
+	var ret []error
+	for _, e := range es.Unwrap() {
+		ret = append(ret, SplitAll(e)...)
+	}
+	return ret
+}
+
+// walkNodes works like [walk] but calls the callback with the [Node]
+// instances. The depth is the depth of the visited error and the path is the
+// path to it.
+func walkNodes(err error, depth int, path []Step, cb func(Node) bool) bool {
+	if err == nil || isNil(err) {
+		return true
+	}
+	switch x := err.(type) { // nolint: errorlint
+	case interface{ Unwrap() error }:
+		if !cb(newNode(err, depth, path)) {
+			return false
+		}
+		step := Step{Kind: StepWrap}
+		return walkNodes(x.Unwrap(), depth+1, appendStep(path, step), cb)
+
+	case Fielder:
+		// Only visit Fielder nodes that also implement Coder; plain
+		// field-map types (e.g., GenericFields) are transparent containers.
+		if _, ok := err.(Coder); ok {
+			if !cb(newNode(err, depth, path)) {
+				return false
+			}
+			depth++
+		}
+		fields, ers := sortFields(x.ErrorFields())
+		for i, fe := range ers {
+			step := Step{Kind: StepField, Field: fields[i]}
+			if !walkNodes(fe, depth, appendStep(path, step), cb) {
+				return false
+			}
+		}
+		return true
+
+	case joined:
+		for i, je := range x.Unwrap() {
+			step := Step{Kind: StepJoin, Index: i}
+			if !walkNodes(je, depth, appendStep(path, step), cb) {
+				return false
+			}
+		}
+		return true
+	}
+	return cb(newNode(err, depth, path))
+}
+
+// newNode returns a new instance of [Node] for the error.
+func newNode(err error, depth int, path []Step) Node {
+	node := Node{Err: err, Depth: depth, Path: path, Code: GetCode(err)}
+	if e, ok := err.(Metadater); ok {
+		if meta := e.MetaAll(); len(meta) > 0 {
+			node.Meta = meta
+		}
+	}
+	return node
+}
+
+// appendStep returns a new path with the step appended to the path. The
+// path is never modified.
+func appendStep(path []Step, step Step) []Step {
+	return append(slices.Clip(path), step)
+}
@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_All(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- Given ---
+		var have []error
+
+		// --- When ---
+		for err := range All(nil) {
+			have = append(have, err)
+		}
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("same order as walk", func(t *testing.T) {
+		// --- Given ---
+		e := TstTreeCase2()
+
+		var have string
+
+		// --- When ---
+		for err := range All(e) {
+			have += GetCode(err)
+		}
+
+		// --- Then ---
+		assert.Equal(t, "abcehdfgi", have)
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		// --- Given ---
+		e := TstTreeCase5()
+
+		var have string
+
+		// --- When ---
+		for err := range All(e) {
+			have += GetCode(err)
+		}
+
+		// --- Then ---
+		assert.Equal(t, "abdegh", have)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		// --- Given ---
+		e := TstTreeCase1()
+
+		var have string
+
+		// --- When ---
+		for err := range All(e) {
+			have += GetCode(err)
+			if GetCode(err) == "e" {
+				break
+			}
+		}
+
+		// --- Then ---
+		assert.Equal(t, "abce", have)
+	})
+}
+
+func Test_Nodes(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- Given ---
+		var have []Node
+
+		// --- When ---
+		for node := range Nodes(nil) {
+			have = append(have, node)
+		}
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		// --- Given ---
+		e := New("msg", "ECode", Meta().Int("A", 1).Option())
+
+		var have []Node
+
+		// --- When ---
+		for node := range Nodes(e) {
+			have = append(have, node)
+		}
+
+		// --- Then ---
+		want := []Node{
+			{Err: e, Code: "ECode", Meta: map[string]any{"A": 1}},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("wrapped and joined errors", func(t *testing.T) {
+		// --- Given ---
+		e := TstTreeCase1()
+
+		var have []Node
+
+		// --- When ---
+		for node := range Nodes(e) {
+			have = append(have, node)
+		}
+
+		// --- Then ---
+		wrap := Step{Kind: StepWrap}
+		j0 := Step{Kind: StepJoin, Index: 0}
+		j1 := Step{Kind: StepJoin, Index: 1}
+
+		assert.Len(t, 7, have)
+		assert.Equal(t, "a", have[0].Code)
+		assert.Equal(t, 0, have[0].Depth)
+		assert.Nil(t, have[0].Path)
+
+		assert.Equal(t, "b", have[1].Code)
+		assert.Equal(t, 1, have[1].Depth)
+		assert.Equal(t, []Step{wrap}, have[1].Path)
+
+		assert.Equal(t, "c", have[2].Code)
+		assert.Equal(t, 2, have[2].Depth)
+		assert.Equal(t, []Step{wrap, wrap, j0}, have[2].Path)
+
+		assert.Equal(t, "e", have[3].Code)
+		assert.Equal(t, 3, have[3].Depth)
+		assert.Equal(t, []Step{wrap, wrap, j0, wrap}, have[3].Path)
+
+		assert.Equal(t, "d", have[4].Code)
+		assert.Equal(t, 2, have[4].Depth)
+		assert.Equal(t, []Step{wrap, wrap, j1}, have[4].Path)
+
+		assert.Equal(t, "f", have[5].Code)
+		assert.Equal(t, 3, have[5].Depth)
+		assert.Equal(t, []Step{wrap, wrap, j1, wrap, j0}, have[5].Path)
+
+		assert.Equal(t, "g", have[6].Code)
+		assert.Equal(t, 3, have[6].Depth)
+		assert.Equal(t, []Step{wrap, wrap, j1, wrap, j1}, have[6].Path)
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		// --- Given ---
+		e := TstTreeCase5()
+
+		var have []Node
+
+		// --- When ---
+		for node := range Nodes(e) {
+			have = append(have, node)
+		}
+
+		// --- Then ---
+		wrap := Step{Kind: StepWrap}
+		fa := Step{Kind: StepField, Field: "a"}
+		ff := Step{Kind: StepField, Field: "f"}
+
+		assert.Len(t, 6, have)
+		assert.Equal(t, "a", have[0].Code)
+		assert.Equal(t, 0, have[0].Depth)
+		assert.Equal(t, []Step{fa}, have[0].Path)
+
+		assert.Equal(t, "b", have[1].Code)
+		assert.Equal(t, 1, have[1].Depth)
+		assert.Equal(t, []Step{fa, wrap}, have[1].Path)
+
+		assert.Equal(t, "h", have[5].Code)
+		assert.Equal(t, 0, have[5].Depth)
+		assert.Equal(t, []Step{ff, {Kind: StepJoin, Index: 1}}, have[5].Path)
+	})
+
+	t.Run("fields with code", func(t *testing.T) {
+		// --- Given ---
+		e := TFielderCoder{
+			code:   "ECFielder",
+			fields: map[string]error{"f": New("msg", "ECode")},
+		}
+
+		var have []Node
+
+		// --- When ---
+		for node := range Nodes(e) {
+			have = append(have, node)
+		}
+
+		// --- Then ---
+		assert.Len(t, 2, have)
+		assert.Equal(t, "ECFielder", have[0].Code)
+		assert.Equal(t, 0, have[0].Depth)
+		assert.Equal(t, "ECode", have[1].Code)
+		assert.Equal(t, 1, have[1].Depth)
+		assert.Equal(t, []Step{{Kind: StepField, Field: "f"}}, have[1].Path)
+	})
+
+	t.Run("own metadata", func(t *testing.T) {
+		// --- Given ---
+		e := TstTreeMeta()
+
+		var have []map[string]any
+
+		// --- When ---
+		for node := range Nodes(e) {
+			have = append(have, node.Meta)
+		}
+
+		// --- Then ---
+		assert.Len(t, 7, have)
+		assert.Equal(t, map[string]any{"A": 7, "B": "b"}, have[0])
+		assert.Equal(t, map[string]any{"A": 1, "D": "h"}, have[6])
+	})
+
+	t.Run("paths are not shared", func(t *testing.T) {
+		// --- Given ---
+		e := errors.Join(
+			New("m0", "EC0"),
+			New("m1", "EC1"),
+		)
+		e = Wrap(Wrap(e))
+
+		var have [][]Step
+
+		// --- When ---
+		for node := range Nodes(e) {
+			have = append(have, node.Path)
+		}
+
+		// --- Then ---
+		wrap := Step{Kind: StepWrap}
+		want := [][]Step{
+			nil,
+			{wrap},
+			{wrap, wrap, {Kind: StepJoin, Index: 0}},
+			{wrap, wrap, {Kind: StepJoin, Index: 1}},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		// --- Given ---
+		e := TstTreeCase5()
+
+		var have string
+
+		// --- When ---
+		for node := range Nodes(e) {
+			have += node.Code
+			if node.Code == "d" {
+				break
+			}
+		}
+
+		// --- Then ---
+		assert.Equal(t, "abd", have)
+	})
+}
+
+func Test_SplitAll(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have := SplitAll(nil)
+
+		// --- Then ---
+		assert.Nil(t, have)
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		// --- Given ---
+		e := errors.New("msg")
+
+		// --- When ---
+		have := SplitAll(e)
+
+		// --- Then ---
+		assert.Len(t, 1, have)
+		assert.Same(t, e, have[0])
+	})
+
+	t.Run("nested joined errors", func(t *testing.T) {
+		// --- Given ---
+		e0 := errors.New("msg0")
+		e1 := errors.New("msg1")
+		e2 := errors.New("msg2")
+		e3 := fmt.Errorf("abc: %w", errors.Join(e0, e1))
+		e := errors.Join(e0, errors.Join(e1, errors.Join(e2)), e3)
+
+		// --- When ---
+		have := SplitAll(e)
+
+		// --- Then ---
+		assert.Len(t, 4, have)
+		assert.Same(t, e0, have[0])
+		assert.Same(t, e1, have[1])
+		assert.Same(t, e2, have[2])
+		assert.Same(t, e3, have[3])
+	})
+}
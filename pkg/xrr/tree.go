@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ANSI escape sequences used by [WithTreeColors].
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiCyan   = "\x1b[36m"
+	ansiGray   = "\x1b[90m"
+	treeIndent = "  "
+)
+
+// TreeOption represents an option for configuring [Tree] rendering.
+type TreeOption func(*TreeOptions)
+
+// TreeOptions is a collection of options used by [Tree].
+type TreeOptions struct {
+	colors bool   // Use ANSI colors.
+	indent string // Indentation for each depth level.
+}
+
+// Set applies the provided options to the [TreeOptions] instance and returns
+// it.
+func (ops TreeOptions) Set(opts ...TreeOption) TreeOptions {
+	for _, opt := range opts {
+		opt(&ops)
+	}
+	return ops
+}
+
+// WithTreeColors is an option rendering the tree with ANSI colors for use
+// in terminals.
+func WithTreeColors() TreeOption {
+	return func(ops *TreeOptions) { ops.colors = true }
+}
+
+// WithTreeIndent is an option setting the indentation used for each depth
+// level. By default, two spaces are used.
+func WithTreeIndent(indent string) TreeOption {
+	return func(ops *TreeOptions) { ops.indent = indent }
+}
+
+// Tree returns a human-readable representation of the error chain (tree).
+// Each error visited by [Nodes] is rendered on a separate line, indented by
+// its depth, in the form:
+//
+//	field: message (code) [domain] {key: value, ...}
+//
+// where the field is the name of the field for field errors, the message is
+// the error's own message without the messages of the errors it wraps, the
+// domain is rendered for errors implementing [Domainer], and the metadata is the
+// error's own metadata with keys sorted. Parts which are empty are omitted.
+// Returns an empty string when err is nil.
+//
+//	op failed (ECOp) [xrr.EDXrr] {attempt: 3}
+//	  email: invalid format (ECFormat) [xrr.EDXrr]
+//	  name: required (ECRequired) [xrr.EDXrr]
+func Tree(err error, opts ...TreeOption) string {
+	ops := TreeOptions{indent: treeIndent}.Set(opts...)
+	var b strings.Builder
+	var paths [][]Step
+	for node := range Nodes(err) {
+		var parent []Step
+		paths = paths[:node.Depth]
+		if node.Depth > 0 {
+			parent = paths[node.Depth-1]
+		}
+		paths = append(paths, node.Path)
+
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(strings.Repeat(ops.indent, node.Depth))
+		writeTreeNode(&b, ops, node, node.Path[len(parent):])
+	}
+	return b.String()
+}
+
+// writeTreeNode writes the node line to b. The steps are the steps from the
+// parent node used to render the field name.
+func writeTreeNode(b *strings.Builder, ops TreeOptions, node Node, steps []Step) {
+	var parts []string
+	var fields string
+	for _, step := range steps {
+		if step.Kind == StepField {
+			fields = prefix(fields, step.Field)
+		}
+	}
+	msg := ownMessage(node.Err)
+	if fields != "" {
+		field := colorize(ops, ansiBold, fields)
+		if msg != "" {
+			msg = field + ": " + msg
+		} else {
+			msg = field + ":"
+		}
+	}
+	if msg != "" {
+		parts = append(parts, msg)
+	}
+	parts = append(parts, colorize(ops, ansiRed, "("+node.Code+")"))
+	if domain := GetDomain(node.Err); domain != "" {
+		parts = append(parts, colorize(ops, ansiCyan, "["+domain+"]"))
+	}
+	if len(node.Meta) > 0 {
+		parts = append(parts, colorize(ops, ansiGray, formatTreeMeta(node.Meta)))
+	}
+	b.WriteString(strings.Join(parts, " "))
+}
+
+// ownMessage returns the message of the error without the message of the
+// error it wraps. Returns the whole message when it cannot be determined.
+func ownMessage(err error) string {
+	if e, ok := err.(interface{ ownMessage() string }); ok {
+		return e.ownMessage()
+	}
+	msg := err.Error()
+	x, ok := err.(interface{ Unwrap() error }) // nolint: errorlint
+	if !ok || x.Unwrap() == nil {
+		return msg
+	}
+	cause := x.Unwrap()
+	for _, em := range []string{errorMessage(cause), cause.Error()} {
+		if msg == em {
+			return ""
+		}
+		if own, found := strings.CutSuffix(msg, ": "+em); found {
+			return own
+		}
+	}
+	return msg
+}
+
+// formatTreeMeta formats metadata with sorted keys.
+func formatTreeMeta(meta map[string]any) string {
+	keys := make([]string, 0, len(meta))
+	for key := range meta {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(key)
+		b.WriteString(": ")
+		b.WriteString(formatTreeValue(meta[key]))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// formatTreeValue formats the metadata value.
+func formatTreeValue(v any) string {
+	switch x := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", x)
+	case time.Time:
+		return x.Format(time.RFC3339Nano)
+	case []string:
+		return fmt.Sprintf("%q", x)
+	case map[string]any:
+		return formatTreeMeta(x)
+	default:
+		return fmt.Sprintf("%v", x)
+	}
+}
+
+// colorize wraps s in the ANSI escape sequence when colors are enabled.
+func colorize(ops TreeOptions, seq, s string) string {
+	if !ops.colors {
+		return s
+	}
+	return seq + s + ansiReset
+}
@@ -0,0 +1,258 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+)
+
+func Test_TreeOptions_Set(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		// --- Given ---
+		ops := TreeOptions{}
+
+		// --- When ---
+		have := ops.Set()
+
+		// --- Then ---
+		assert.Zero(t, have)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- Given ---
+		ops := TreeOptions{}
+
+		// --- When ---
+		have := ops.Set(WithTreeColors(), WithTreeIndent("\t"))
+
+		// --- Then ---
+		assert.Equal(t, TreeOptions{colors: true, indent: "\t"}, have)
+		assert.Zero(t, ops)
+	})
+}
+
+func Test_WithTreeColors(t *testing.T) {
+	// --- Given ---
+	ops := &TreeOptions{}
+
+	// --- When ---
+	WithTreeColors()(ops)
+
+	// --- Then ---
+	assert.True(t, ops.colors)
+}
+
+func Test_WithTreeIndent(t *testing.T) {
+	// --- Given ---
+	ops := &TreeOptions{}
+
+	// --- When ---
+	WithTreeIndent("\t")(ops)
+
+	// --- Then ---
+	assert.Equal(t, "\t", ops.indent)
+}
+
+func Test_Tree(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- When ---
+		have := Tree(nil)
+
+		// --- Then ---
+		assert.Equal(t, "", have)
+	})
+
+	t.Run("standard error", func(t *testing.T) {
+		// --- Given ---
+		e := errors.New("msg")
+
+		// --- When ---
+		have := Tree(e)
+
+		// --- Then ---
+		assert.Equal(t, "msg (ECGeneric)", have)
+	})
+
+	t.Run("wrapped and joined errors", func(t *testing.T) {
+		// --- Given ---
+		e := New(
+			"op failed",
+			"ECOp",
+			WithCause(errors.Join(
+				New("m0", "EC0"),
+				fmt.Errorf("m1: %w", New("m2", "EC2")),
+			)),
+			Meta().Int("attempt", 3).Str("user", "u-1").Option(),
+		)
+
+		// --- When ---
+		have := Tree(e)
+
+		// --- Then ---
+		want := "" +
+			"op failed (ECOp) [xrr.EDXrr] {attempt: 3, user: \"u-1\"}\n" +
+			"  m0 (EC0) [xrr.EDXrr]\n" +
+			"  m1 (ECGeneric)\n" +
+			"    m2 (EC2) [xrr.EDXrr]"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("wrap without message", func(t *testing.T) {
+		// --- Given ---
+		e := Wrap(New("msg", "ECode"), Meta().Bool("A", true).Option())
+
+		// --- When ---
+		have := Tree(e)
+
+		// --- Then ---
+		want := "" +
+			"(ECode) [xrr.EDXrr] {A: true}\n" +
+			"  msg (ECode) [xrr.EDXrr]"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		// --- Given ---
+		e := New("invalid", "ECInvalid", WithCause(NewFieldErrors(
+			map[string]error{
+				"email": New("invalid format", "ECFormat"),
+				"name":  Wrap(New("required", "ECRequired")),
+				"addr":  NewFieldError("city", New("required", "ECRequired")),
+			},
+		)))
+
+		// --- When ---
+		have := Tree(e)
+
+		// --- Then ---
+		want := "" +
+			"invalid (ECInvalid) [xrr.EDXrr]\n" +
+			"  addr.city: required (ECRequired) [xrr.EDXrr]\n" +
+			"  email: invalid format (ECFormat) [xrr.EDXrr]\n" +
+			"  name: (ECRequired) [xrr.EDXrr]\n" +
+			"    required (ECRequired) [xrr.EDXrr]"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("fields with index path", func(t *testing.T) {
+		// --- Given ---
+		fc := NewFieldCollector[EDXrr]()
+		fc.Field("items").Index(3).Add("price", New("negative", "ECNeg"))
+
+		// --- When ---
+		have := Tree(fc.Err())
+
+		// --- Then ---
+		want := "items[3].price: negative (ECNeg) [xrr.EDXrr]"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("fields with code", func(t *testing.T) {
+		// --- Given ---
+		e := TFielderCoder{
+			code:   "ECFielder",
+			fields: map[string]error{"f": New("msg", "ECode")},
+		}
+
+		// --- When ---
+		have := Tree(e)
+
+		// --- Then ---
+		want := "" +
+			"fielder coder (ECFielder)\n" +
+			"  f: msg (ECode) [xrr.EDXrr]"
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("custom indent", func(t *testing.T) {
+		// --- Given ---
+		e := New("m0", "EC0", WithCause(New("m1", "EC1")))
+
+		// --- When ---
+		have := Tree(e, WithTreeIndent("\t"))
+
+		// --- Then ---
+		assert.Equal(t, "m0 (EC0) [xrr.EDXrr]\n\tm1 (EC1) [xrr.EDXrr]", have)
+	})
+
+	t.Run("colors", func(t *testing.T) {
+		// --- Given ---
+		e := NewFieldError("f", New("msg", "ECode", WithMeta(map[string]any{"A": 1})))
+
+		// --- When ---
+		have := Tree(e, WithTreeColors())
+
+		// --- Then ---
+		want := "" +
+			"\x1b[1mf\x1b[0m: msg " +
+			"\x1b[31m(ECode)\x1b[0m " +
+			"\x1b[36m[xrr.EDXrr]\x1b[0m " +
+			"\x1b[90m{A: 1}\x1b[0m"
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_ownMessage_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		err  error
+		want string
+	}{
+		{"standard error", errors.New("msg"), "msg"},
+		{"generic error", New("m0", "EC0", WithCause(New("m1", "EC1"))), "m0"},
+		{"wrapped generic error", Wrap(New("m0", "EC0")), ""},
+		{"fmt wrapped error", fmt.Errorf("m0: %w", errors.New("m1")), "m0"},
+		{"fmt wrapped error without prefix", fmt.Errorf("%w", errors.New("m1")), ""},
+		{
+			"fmt wrapped joined error",
+			fmt.Errorf("m0: %w", errors.Join(errors.New("a"), errors.New("b"))),
+			"m0",
+		},
+		{"fmt wrapped error with suffix", fmt.Errorf("%w: m0", errors.New("m1")), "m1: m0"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := ownMessage(tc.err)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_formatTreeMeta(t *testing.T) {
+	// --- Given ---
+	meta := map[string]any{
+		"str":   "abc",
+		"int":   1,
+		"time":  time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC),
+		"dur":   time.Second,
+		"strs":  []string{"a", "b"},
+		"ints":  []int{1, 2},
+		"group": map[string]any{"B": 2, "A": "a"},
+	}
+
+	// --- When ---
+	have := formatTreeMeta(meta)
+
+	// --- Then ---
+	want := "{" +
+		"dur: 1s, " +
+		"group: {A: \"a\", B: 2}, " +
+		"int: 1, " +
+		"ints: [1 2], " +
+		"str: \"abc\", " +
+		"strs: [\"a\" \"b\"], " +
+		"time: 2000-01-02T03:04:05Z" +
+		"}"
+	assert.Equal(t, want, have)
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package validate provides composable validation rules producing coded
+// field errors.
+//
+// Rules are functions validating a single value. Failing rules return errors
+// with the codes declared in this package and the rule parameters (for
+// example, "min", "max" and "allowed") as the error metadata, so clients can
+// render precise messages. The errors are collected with the
+// [xrr.FieldCollector] as nested [xrr.GenericFields]:
+//
+//	fc := xrr.NewFieldCollector[edUser]()
+//	validate.Field(fc, "name", u.Name, validate.Required, validate.Length(1, 50))
+//	validate.Field(fc, "email", u.Email, validate.Required, validate.Email)
+//	validate.Field(fc, "role", u.Role, validate.OneOf("admin", "user"))
+//	validate.Field(fc, "confirm", u.Confirm, validate.EqField("password", u.Password))
+//	validate.Struct(fc, "address", u.Address, validateAddress)
+//	validate.Slice(fc, "items", u.Items, validateItem)
+//	err := fc.Err() // Nil when all the rules pass.
+//
+// Collectors created with [xrr.NewStructFieldCollector] accept the Go field
+// names and collect the errors under the names from the struct tags.
+//
+// Rules other than [Required] pass for empty values: empty strings, slices
+// and maps, and nil pointers. Numeric zero and false are not empty, so, for
+// example, Range(18, 99) fails for the unset age of 0, validate optional
+// numbers only when they are set. Use [Required] for the values which must
+// be present, it fails for empty and zero values.
+package validate
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package validate
+
+import (
+	"cmp"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+)
+
+// Required is the rule failing with [ECRequired] for empty (see [isEmpty])
+// and zero values, including numeric zero and false.
+func Required[V any](v V) error {
+	if isEmpty(v) || valueOf(v).IsZero() {
+		return fail(ECRequired, nil)
+	}
+	return nil
+}
+
+// Length returns the rule failing with [ECLength] when the number of
+// characters (runes) is not between min and max, inclusive.
+func Length(min, max int) Rule[string] {
+	return func(v string) error {
+		if n := utf8.RuneCountInString(v); v == "" || n >= min && n <= max {
+			return nil
+		}
+		return fail(ECLength, map[string]any{MetaMin: min, MetaMax: max})
+	}
+}
+
+// MinLength returns the rule failing with [ECMinLength] when the number of
+// characters (runes) is less than min.
+func MinLength(min int) Rule[string] {
+	return func(v string) error {
+		if v == "" || utf8.RuneCountInString(v) >= min {
+			return nil
+		}
+		return fail(ECMinLength, map[string]any{MetaMin: min})
+	}
+}
+
+// MaxLength returns the rule failing with [ECMaxLength] when the number of
+// characters (runes) is greater than max.
+func MaxLength(max int) Rule[string] {
+	return func(v string) error {
+		if utf8.RuneCountInString(v) <= max {
+			return nil
+		}
+		return fail(ECMaxLength, map[string]any{MetaMax: max})
+	}
+}
+
+// Count returns the rule failing with [ECCount] when the number of items is
+// not between min and max, inclusive.
+func Count[E any](min, max int) Rule[[]E] {
+	return func(v []E) error {
+		if n := len(v); n == 0 || n >= min && n <= max {
+			return nil
+		}
+		return fail(ECCount, map[string]any{MetaMin: min, MetaMax: max})
+	}
+}
+
+// Range returns the rule failing with [ECRange] when the value is not
+// between min and max, inclusive. Numeric zero is not empty, the rule is
+// checked for it, so optional numbers should be validated only when set.
+func Range[N cmp.Ordered](min, max N) Rule[N] {
+	return func(v N) error {
+		if isEmpty(v) || v >= min && v <= max {
+			return nil
+		}
+		meta := map[string]any{MetaMin: metaValue(min), MetaMax: metaValue(max)}
+		return fail(ECRange, meta)
+	}
+}
+
+// Min returns the rule failing with [ECMin] when the value is less than min.
+// Like [Range], it is checked for numeric zero.
+func Min[N cmp.Ordered](min N) Rule[N] {
+	return func(v N) error {
+		if isEmpty(v) || v >= min {
+			return nil
+		}
+		return fail(ECMin, map[string]any{MetaMin: metaValue(min)})
+	}
+}
+
+// Max returns the rule failing with [ECMax] when the value is greater than
+// max. Like [Range], it is checked for numeric zero.
+func Max[N cmp.Ordered](max N) Rule[N] {
+	return func(v N) error {
+		if isEmpty(v) || v <= max {
+			return nil
+		}
+		return fail(ECMax, map[string]any{MetaMax: metaValue(max)})
+	}
+}
+
+// Pattern returns the rule failing with [ECPattern] when the value does not
+// match the regular expression.
+func Pattern(re *regexp.Regexp) Rule[string] {
+	return func(v string) error {
+		if v == "" || re.MatchString(v) {
+			return nil
+		}
+		return fail(ECPattern, map[string]any{MetaPattern: re.String()})
+	}
+}
+
+// OneOf returns the rule failing with [ECOneOf] when the value is not one of
+// the allowed values.
+func OneOf[V comparable](allowed ...V) Rule[V] {
+	return func(v V) error {
+		if isEmpty(v) || slices.Contains(allowed, v) {
+			return nil
+		}
+		return fail(ECOneOf, map[string]any{MetaAllowed: metaValues(allowed)})
+	}
+}
+
+// Email is the rule failing with [ECEmail] when the value is not a plain
+// email address, for example, "user@example.com". Addresses with display
+// names or in angle brackets are not valid.
+func Email[S ~string](v S) error {
+	if v == "" {
+		return nil
+	}
+	addr, err := mail.ParseAddress(string(v))
+	if err != nil || addr.Name != "" || addr.Address != string(v) {
+		return fail(ECEmail, nil)
+	}
+	return nil
+}
+
+// URL is the rule failing with [ECURL] when the value is not an absolute URL
+// with a scheme and a host, for example, "https://example.com/path".
+func URL[S ~string](v S) error {
+	if v == "" {
+		return nil
+	}
+	u, err := url.Parse(string(v))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fail(ECURL, nil)
+	}
+	return nil
+}
+
+// UUID is the rule failing with [ECUUID] when the value is not a UUID in the
+// canonical form, for example, "f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func UUID[S ~string](v S) error {
+	if v == "" || isUUID(string(v)) {
+		return nil
+	}
+	return fail(ECUUID, nil)
+}
+
+// isUUID returns true if the string is a UUID in the canonical form.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// EqField returns the rule failing with [ECEqField] when the value is not
+// equal to the value of the other field, for example, the password
+// confirmation.
+func EqField[V comparable](field string, other V) Rule[V] {
+	return func(v V) error {
+		if isEmpty(v) || v == other {
+			return nil
+		}
+		return fail(ECEqField, map[string]any{MetaField: field})
+	}
+}
+
+// NeField returns the rule failing with [ECNeField] when the value is equal
+// to the value of the other field.
+func NeField[V comparable](field string, other V) Rule[V] {
+	return func(v V) error {
+		if isEmpty(v) || v != other {
+			return nil
+		}
+		return fail(ECNeField, map[string]any{MetaField: field})
+	}
+}
+
+// GtField returns the rule failing with [ECGtField] when the value is not
+// greater than the value of the other field.
+func GtField[N cmp.Ordered](field string, other N) Rule[N] {
+	return compareField(ECGtField, field, other, func(c int) bool { return c > 0 })
+}
+
+// GteField returns the rule failing with [ECGteField] when the value is less
+// than the value of the other field.
+func GteField[N cmp.Ordered](field string, other N) Rule[N] {
+	return compareField(ECGteField, field, other, func(c int) bool { return c >= 0 })
+}
+
+// LtField returns the rule failing with [ECLtField] when the value is not
+// less than the value of the other field.
+func LtField[N cmp.Ordered](field string, other N) Rule[N] {
+	return compareField(ECLtField, field, other, func(c int) bool { return c < 0 })
+}
+
+// LteField returns the rule failing with [ECLteField] when the value is
+// greater than the value of the other field.
+func LteField[N cmp.Ordered](field string, other N) Rule[N] {
+	return compareField(ECLteField, field, other, func(c int) bool { return c <= 0 })
+}
+
+// compareField returns the rule failing with the code when the result of
+// comparing the value with the value of the other field (see [cmp.Compare])
+// is not accepted by the ok function.
+func compareField[N cmp.Ordered](code, field string, other N, ok func(c int) bool) Rule[N] {
+	return func(v N) error {
+		if isEmpty(v) || ok(cmp.Compare(v, other)) {
+			return nil
+		}
+		return fail(code, map[string]any{MetaField: field})
+	}
+}
@@ -0,0 +1,550 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package validate
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+func Test_Required_tabular(t *testing.T) {
+	type tStruct struct{ A int }
+
+	tt := []struct {
+		testN string
+
+		v    any
+		code string
+	}{
+		{"string", "a", ""},
+		{"empty string", "", ECRequired},
+		{"int", 1, ""},
+		{"zero int", 0, ECRequired},
+		{"false", false, ECRequired},
+		{"slice", []int{1}, ""},
+		{"empty slice", []int{}, ECRequired},
+		{"nil map", map[string]int(nil), ECRequired},
+		{"struct", tStruct{A: 1}, ""},
+		{"zero struct", tStruct{}, ECRequired},
+		{"nil pointer", (*tStruct)(nil), ECRequired},
+		{"pointer to zero", &tStruct{}, ""},
+		{"nil", nil, ECRequired},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Required(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+		})
+	}
+}
+
+func Test_Required(t *testing.T) {
+	t.Run("zero int", func(t *testing.T) {
+		// --- When ---
+		err := Required(0)
+
+		// --- Then ---
+		assert.Equal(t, ECRequired, xrr.GetCode(err))
+		assert.Nil(t, xrr.GetMeta(err))
+	})
+
+	t.Run("int", func(t *testing.T) {
+		// --- When ---
+		err := Required(1)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+}
+
+func Test_Length_tabular(t *testing.T) {
+	meta := map[string]any{MetaMin: 2, MetaMax: 3}
+
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+		meta map[string]any
+	}{
+		{"min", "ab", "", nil},
+		{"max", "abc", "", nil},
+		{"runes", "żół", "", nil},
+		{"too short", "a", ECLength, meta},
+		{"too long", "abcd", ECLength, meta},
+		{"multibyte too long", "żółw", ECLength, meta},
+		{"empty", "", "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Length(2, 3)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_MinLength_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+		meta map[string]any
+	}{
+		{"valid", "ab", "", nil},
+		{"too short", "a", ECMinLength, map[string]any{MetaMin: 2}},
+		{"empty", "", "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := MinLength(2)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_MaxLength_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+		meta map[string]any
+	}{
+		{"valid", "ab", "", nil},
+		{"runes", "żó", "", nil},
+		{"too long", "abc", ECMaxLength, map[string]any{MetaMax: 2}},
+		{"empty", "", "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := MaxLength(2)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_Count_tabular(t *testing.T) {
+	meta := map[string]any{MetaMin: 2, MetaMax: 3}
+
+	tt := []struct {
+		testN string
+
+		v    []int
+		code string
+		meta map[string]any
+	}{
+		{"valid", []int{1, 2}, "", nil},
+		{"too few", []int{1}, ECCount, meta},
+		{"too many", []int{1, 2, 3, 4}, ECCount, meta},
+		{"empty", nil, "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Count[int](2, 3)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_Range_tabular(t *testing.T) {
+	meta := map[string]any{MetaMin: 1, MetaMax: 3}
+
+	tt := []struct {
+		testN string
+
+		v    int
+		code string
+		meta map[string]any
+	}{
+		{"min", 1, "", nil},
+		{"max", 3, "", nil},
+		{"below", -1, ECRange, meta},
+		{"above", 4, ECRange, meta},
+		{"zero is not empty", 0, ECRange, meta},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Range(1, 3)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_Range(t *testing.T) {
+	t.Run("float", func(t *testing.T) {
+		// --- When ---
+		err := Range(0.5, 1.5)(2)
+
+		// --- Then ---
+		assert.Equal(t, ECRange, xrr.GetCode(err))
+		want := map[string]any{MetaMin: 0.5, MetaMax: 1.5}
+		assert.Equal(t, want, xrr.GetMeta(err))
+	})
+
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		err := Range("b", "d")("a")
+
+		// --- Then ---
+		assert.Equal(t, ECRange, xrr.GetCode(err))
+		want := map[string]any{MetaMin: "b", MetaMax: "d"}
+		assert.Equal(t, want, xrr.GetMeta(err))
+	})
+
+	t.Run("empty string", func(t *testing.T) {
+		// --- When ---
+		err := Range("b", "d")("")
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+}
+
+func Test_Min_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    int
+		code string
+		meta map[string]any
+	}{
+		{"valid", 1, "", nil},
+		{"below", -1, ECMin, map[string]any{MetaMin: 1}},
+		{"zero is not empty", 0, ECMin, map[string]any{MetaMin: 1}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Min(1)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_Min(t *testing.T) {
+	// --- When ---
+	err := Min[uint8](2)(1)
+
+	// --- Then ---
+	assert.Equal(t, ECMin, xrr.GetCode(err))
+	assert.Equal(t, map[string]any{MetaMin: 2}, xrr.GetMeta(err))
+}
+
+func Test_Max_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    float64
+		code string
+		meta map[string]any
+	}{
+		{"valid", 1, "", nil},
+		{"above", 2, ECMax, map[string]any{MetaMax: 1.5}},
+		{"zero", 0, "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Max(1.5)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_Max(t *testing.T) {
+	// --- When ---
+	err := Max(1)(2)
+
+	// --- Then ---
+	assert.Equal(t, ECMax, xrr.GetCode(err))
+	assert.Equal(t, map[string]any{MetaMax: 1}, xrr.GetMeta(err))
+}
+
+func Test_Pattern_tabular(t *testing.T) {
+	re := regexp.MustCompile(`^[a-z]+$`)
+
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+		meta map[string]any
+	}{
+		{"match", "abc", "", nil},
+		{"no match", "ab1", ECPattern, map[string]any{MetaPattern: `^[a-z]+$`}},
+		{"empty", "", "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Pattern(re)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_OneOf_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+		meta map[string]any
+	}{
+		{"allowed", "b", "", nil},
+		{"not allowed", "c", ECOneOf, map[string]any{MetaAllowed: []string{"a", "b"}}},
+		{"empty", "", "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := OneOf("a", "b")(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_OneOf(t *testing.T) {
+	// --- When ---
+	err := OneOf(1, 2)(3)
+
+	// --- Then ---
+	assert.Equal(t, ECOneOf, xrr.GetCode(err))
+	assert.Equal(t, map[string]any{MetaAllowed: []int{1, 2}}, xrr.GetMeta(err))
+}
+
+func Test_Email_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+	}{
+		{"valid", "user@example.com", ""},
+		{"plus", "user+tag@example.com", ""},
+		{"no at", "user", ECEmail},
+		{"display name", "User <user@example.com>", ECEmail},
+		{"angle brackets", "<user@example.com>", ECEmail},
+		{"spaces", " user@example.com", ECEmail},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := Email(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+		})
+	}
+}
+
+func Test_URL_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+	}{
+		{"valid", "https://example.com/a?b=c", ""},
+		{"no scheme", "example.com", ECURL},
+		{"no host", "mailto:user@example.com", ECURL},
+		{"invalid", "http://[::1", ECURL},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := URL(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+		})
+	}
+}
+
+func Test_UUID_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+	}{
+		{"valid", "f47ac10b-58cc-4372-a567-0e02b2c3d479", ""},
+		{"upper case", "F47AC10B-58CC-4372-A567-0E02B2C3D479", ""},
+		{"too short", "f47ac10b-58cc-4372-a567-0e02b2c3d47", ECUUID},
+		{"no hyphens", "f47ac10b58cc4372a5670e02b2c3d479abcd", ECUUID},
+		{"not hex", "g47ac10b-58cc-4372-a567-0e02b2c3d479", ECUUID},
+		{"empty", "", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := UUID(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+		})
+	}
+}
+
+func Test_EqField_tabular(t *testing.T) {
+	meta := map[string]any{MetaField: "password"}
+
+	tt := []struct {
+		testN string
+
+		v    string
+		code string
+		meta map[string]any
+	}{
+		{"equal", "secret", "", nil},
+		{"not equal", "other", ECEqField, meta},
+		{"empty", "", "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := EqField("password", "secret")(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_NeField_tabular(t *testing.T) {
+	meta := map[string]any{MetaField: "old"}
+
+	tt := []struct {
+		testN string
+
+		v     string
+		other string
+		code  string
+		meta  map[string]any
+	}{
+		{"not equal", "other", "secret", "", nil},
+		{"equal", "secret", "secret", ECNeField, meta},
+		{"empty", "", "", "", nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := NeField("old", tc.other)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_compareField_tabular(t *testing.T) {
+	meta := map[string]any{MetaField: "start"}
+
+	tt := []struct {
+		testN string
+
+		rule func(field string, other int) Rule[int]
+		v    int
+		code string
+		meta map[string]any
+	}{
+		{"gt valid", GtField[int], 2, "", nil},
+		{"gt equal", GtField[int], 1, ECGtField, meta},
+		{"gte equal", GteField[int], 1, "", nil},
+		{"gte less", GteField[int], 0, ECGteField, meta},
+		{"lt valid", LtField[int], 0, "", nil},
+		{"lt equal", LtField[int], 1, ECLtField, meta},
+		{"lte equal", LteField[int], 1, "", nil},
+		{"lte greater", LteField[int], 2, ECLteField, meta},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			err := tc.rule("start", 1)(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.code, xrr.GetCode(err))
+			assert.Equal(t, tc.meta, xrr.GetMeta(err))
+		})
+	}
+}
+
+func Test_compareField(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		// --- When ---
+		err := GtField("start", "b")("a")
+
+		// --- Then ---
+		assert.Equal(t, ECGtField, xrr.GetCode(err))
+		assert.Equal(t, map[string]any{MetaField: "start"}, xrr.GetMeta(err))
+	})
+
+	t.Run("empty string", func(t *testing.T) {
+		// --- When ---
+		err := GtField("start", "b")("")
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package validate
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+// Validation error codes.
+const (
+	ECRequired  = "ECRequired"  // The value is required.
+	ECLength    = "ECLength"    // The length is out of range.
+	ECMinLength = "ECMinLength" // The length is below the minimum.
+	ECMaxLength = "ECMaxLength" // The length is above the maximum.
+	ECCount     = "ECCount"     // The number of items is out of range.
+	ECRange     = "ECRange"     // The value is out of range.
+	ECMin       = "ECMin"       // The value is below the minimum.
+	ECMax       = "ECMax"       // The value is above the maximum.
+	ECPattern   = "ECPattern"   // The value does not match the pattern.
+	ECOneOf     = "ECOneOf"     // The value is not one of the allowed values.
+	ECEmail     = "ECEmail"     // The value is not an email address.
+	ECURL       = "ECURL"       // The value is not an absolute URL.
+	ECUUID      = "ECUUID"      // The value is not a UUID.
+	ECEqField   = "ECEqField"   // The value is not equal to the other field.
+	ECNeField   = "ECNeField"   // The value is equal to the other field.
+	ECGtField   = "ECGtField"   // The value is not greater than the other field.
+	ECGteField  = "ECGteField"  // The value is less than the other field.
+	ECLtField   = "ECLtField"   // The value is not less than the other field.
+	ECLteField  = "ECLteField"  // The value is greater than the other field.
+)
+
+// Metadata keys of the rule parameters.
+const (
+	MetaMin     = "min"     // Minimum value, length or number of items.
+	MetaMax     = "max"     // Maximum value, length or number of items.
+	MetaAllowed = "allowed" // Allowed values.
+	MetaPattern = "pattern" // Regular expression.
+	MetaField   = "field"   // Name of the other field.
+)
+
+// edValidate is the marker type for the package's error domain.
+type edValidate struct{}
+
+// Error constructor function for the package domain.
+var newError = xrr.TemplateFunc[edValidate]()
+
+// Message templates by code. See [xrr.TemplateFunc].
+var templates = map[string]string{
+	ECRequired:  "value is required",
+	ECLength:    "length must be between {min} and {max}",
+	ECMinLength: "length must be at least {min}",
+	ECMaxLength: "length must be at most {max}",
+	ECCount:     "number of items must be between {min} and {max}",
+	ECRange:     "value must be between {min} and {max}",
+	ECMin:       "value must be at least {min}",
+	ECMax:       "value must be at most {max}",
+	ECPattern:   "value must match the pattern {pattern}",
+	ECOneOf:     "value must be one of {allowed}",
+	ECEmail:     "value must be a valid email address",
+	ECURL:       "value must be a valid URL",
+	ECUUID:      "value must be a valid UUID",
+	ECEqField:   "value must be equal to {field}",
+	ECNeField:   "value must not be equal to {field}",
+	ECGtField:   "value must be greater than {field}",
+	ECGteField:  "value must be greater than or equal to {field}",
+	ECLtField:   "value must be less than {field}",
+	ECLteField:  "value must be less than or equal to {field}",
+}
+
+func init() {
+	codes := []xrr.CodeInfo{
+		{Code: ECRequired, Description: "Required value is missing."},
+		{Code: ECLength, Description: "Length is out of range."},
+		{Code: ECMinLength, Description: "Length is below the minimum."},
+		{Code: ECMaxLength, Description: "Length is above the maximum."},
+		{Code: ECCount, Description: "Number of items is out of range."},
+		{Code: ECRange, Description: "Value is out of range."},
+		{Code: ECMin, Description: "Value is below the minimum."},
+		{Code: ECMax, Description: "Value is above the maximum."},
+		{Code: ECPattern, Description: "Value does not match the pattern."},
+		{Code: ECOneOf, Description: "Value is not one of the allowed values."},
+		{Code: ECEmail, Description: "Value is not a valid email address."},
+		{Code: ECURL, Description: "Value is not a valid absolute URL."},
+		{Code: ECUUID, Description: "Value is not a valid UUID."},
+		{Code: ECEqField, Description: "Value is not equal to the other field."},
+		{Code: ECNeField, Description: "Value is equal to the other field."},
+		{Code: ECGtField, Description: "Value is not greater than the other field."},
+		{Code: ECGteField, Description: "Value is less than the other field."},
+		{Code: ECLtField, Description: "Value is not less than the other field."},
+		{Code: ECLteField, Description: "Value is greater than the other field."},
+	}
+	for i := range codes {
+		codes[i].Message = templates[codes[i].Code]
+		codes[i].Status = http.StatusUnprocessableEntity
+		codes[i].Public = true
+	}
+	xrr.Register[edValidate](codes...)
+}
+
+// Rule represents a validation rule for values of type V. It returns nil
+// when the value is valid.
+type Rule[V any] func(v V) error
+
+// Field validates the value of the field with the rules and adds the errors
+// of all failing rules to the collector (see [xrr.FieldCollector.Add]). The
+// remaining rules are not checked when the value is missing (see
+// [Required]).
+func Field[T xrr.Domain, V any](
+	fc *xrr.FieldCollector[T],
+	name string,
+	value V,
+	rules ...Rule[V],
+) {
+	for _, err := range xrr.Split(Check(value, rules...)) {
+		fc.Add(name, err)
+	}
+}
+
+// Each validates each of the items with the rules and adds the errors to the
+// collector for the item indexes of the field, for example, "tags[2]".
+func Each[T xrr.Domain, E any](
+	fc *xrr.FieldCollector[T],
+	name string,
+	items []E,
+	rules ...Rule[E],
+) {
+	sc := fc.Field(name)
+	for i, item := range items {
+		Field(sc.Index(i), "", item, rules...)
+	}
+}
+
+// Struct validates the nested structure with the function receiving the
+// collector scoped to the field. The function is not called for empty
+// values, for example, nil pointers.
+func Struct[T xrr.Domain, V any](
+	fc *xrr.FieldCollector[T],
+	name string,
+	value V,
+	fn func(fc *xrr.FieldCollector[T], v V),
+) {
+	if isEmpty(value) {
+		return
+	}
+	fn(fc.Field(name), value)
+}
+
+// Slice validates each of the items with the function receiving the
+// collector scoped to the item index of the field, for example, "items[2]".
+func Slice[T xrr.Domain, E any](
+	fc *xrr.FieldCollector[T],
+	name string,
+	items []E,
+	fn func(fc *xrr.FieldCollector[T], item E),
+) {
+	sc := fc.Field(name)
+	for i, item := range items {
+		fn(sc.Index(i), item)
+	}
+}
+
+// Check validates the value with the rules and returns the errors of all
+// failing rules joined with [xrr.Join]. Returns nil when the value is valid.
+// The remaining rules are not checked when the value is missing (see
+// [Required]).
+func Check[V any](value V, rules ...Rule[V]) error {
+	var ers []error
+	for _, rule := range rules {
+		err := rule(value)
+		if err == nil {
+			continue
+		}
+		ers = append(ers, err)
+		if xrr.GetCode(err) == ECRequired {
+			break
+		}
+	}
+	return xrr.Join(ers...)
+}
+
+// fail returns the rule error with the code and the metadata.
+func fail(code string, meta map[string]any) error {
+	return newError(templates[code], code, xrr.WithMeta(meta))
+}
+
+// isEmpty returns true for empty strings, slices and maps, and nil pointers
+// and interfaces. Values in interfaces are checked the same way. Numbers,
+// including zero, booleans and structs are never empty.
+func isEmpty[V any](v V) bool {
+	rv := valueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+// valueOf returns the [reflect.Value] of v, which is the value in the
+// interface when V is an interface type holding a value.
+func valueOf[V any](v V) reflect.Value {
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// metaValue returns the value converted to the type supported by the error
+// metadata (see [xrr.MetaType]). Integers are converted to int when they fit,
+// other values not supported by the metadata are formatted with
+// [fmt.Sprint].
+func metaValue(v any) any {
+	switch v.(type) {
+	case time.Time, time.Duration:
+		return v
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i := rv.Int(); i >= math.MinInt && i <= math.MaxInt {
+			return int(i)
+		}
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u <= math.MaxInt {
+			return int(u)
+		}
+		return rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// metaValues returns the values as []int when all of them are converted to
+// int by [metaValue], otherwise as []string.
+func metaValues[V any](vs []V) any {
+	ints := make([]int, 0, len(vs))
+	for _, v := range vs {
+		i, ok := metaValue(v).(int)
+		if !ok {
+			break
+		}
+		ints = append(ints, i)
+	}
+	if len(ints) == len(vs) && len(vs) > 0 {
+		return ints
+	}
+	strs := make([]string, 0, len(vs))
+	for _, v := range vs {
+		strs = append(strs, fmt.Sprint(metaValue(v)))
+	}
+	return strs
+}
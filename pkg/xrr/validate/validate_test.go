@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+// edTest is the error domain used in tests.
+type edTest struct{}
+
+type tAddress struct {
+	City string
+}
+
+type tItem struct {
+	Name string
+	Qty  int
+}
+
+func Test_init(t *testing.T) {
+	for code := range templates {
+		// --- When ---
+		have, ok := xrr.Lookup(code)
+
+		// --- Then ---
+		assert.True(t, ok)
+		assert.Equal(t, "validate.edValidate", have.Domain)
+		assert.Equal(t, templates[code], have.Message)
+		assert.Equal(t, http.StatusUnprocessableEntity, have.Status)
+		assert.True(t, have.Public)
+		assert.NotEmpty(t, have.Description)
+	}
+}
+
+func Test_Field(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- Given ---
+		fc := xrr.NewFieldCollector[edTest]()
+
+		// --- When ---
+		Field(fc, "name", "Bob", Required, Length(1, 10))
+
+		// --- Then ---
+		assert.NoError(t, fc.Err())
+	})
+
+	t.Run("all failing rules are reported", func(t *testing.T) {
+		// --- Given ---
+		fc := xrr.NewFieldCollector[edTest]()
+		digit := Pattern(regexp.MustCompile(`\d`))
+
+		// --- When ---
+		Field(fc, "password", "abc", Required, MinLength(8), digit)
+
+		// --- Then ---
+		err := fc.Err()
+		want := "password: length must be at least 8; " +
+			"password: value must match the pattern \\d"
+		assert.Equal(t, want, err.Error())
+		assert.Equal(t, []string{ECMinLength, ECPattern}, xrr.GetCodes(err))
+	})
+
+	t.Run("empty field name", func(t *testing.T) {
+		// --- Given ---
+		fc := xrr.NewFieldCollector[edTest]()
+
+		// --- When ---
+		Field(fc, "", "", Required)
+
+		// --- Then ---
+		err := fc.Err()
+		assert.Equal(t, "value is required", err.Error())
+		assert.Equal(t, []string{ECRequired}, xrr.GetCodes(err))
+	})
+
+	t.Run("required stops checking", func(t *testing.T) {
+		// --- Given ---
+		fc := xrr.NewFieldCollector[edTest]()
+
+		// --- When ---
+		Field(fc, "age", 0, Required, Range(18, 130))
+
+		// --- Then ---
+		err := fc.Err()
+		assert.Equal(t, "age: value is required", err.Error())
+		assert.Equal(t, []string{ECRequired}, xrr.GetCodes(err))
+	})
+
+	t.Run("field is added to the collector scope", func(t *testing.T) {
+		// --- Given ---
+		fc := xrr.NewFieldCollector[edTest]()
+
+		// --- When ---
+		Field(fc.Field("user"), "age", 10, Range(18, 130))
+
+		// --- Then ---
+		err := fc.Err()
+		fe := xrr.GetFieldError(err, "user.age")
+		assert.Equal(t, ECRange, xrr.GetCode(fe))
+		assert.Equal(t, map[string]any{MetaMin: 18, MetaMax: 130}, xrr.GetMeta(fe))
+	})
+}
+
+func Test_Each(t *testing.T) {
+	// --- Given ---
+	fc := xrr.NewFieldCollector[edTest]()
+	tags := []string{"go", "", "rust"}
+
+	// --- When ---
+	Each(fc, "tags", tags, Required, OneOf("go", "zig"))
+
+	// --- Then ---
+	want := "tags[1]: value is required; tags[2]: value must be one of [go zig]"
+	assert.Equal(t, want, fc.Err().Error())
+}
+
+func Test_Struct(t *testing.T) {
+	validateAddress := func(fc *xrr.FieldCollector[edTest], v *tAddress) {
+		Field(fc, "city", v.City, Required)
+	}
+
+	t.Run("nested structure", func(t *testing.T) {
+		// --- Given ---
+		fc := xrr.NewFieldCollector[edTest]()
+
+		// --- When ---
+		Struct(fc, "address", &tAddress{}, validateAddress)
+
+		// --- Then ---
+		assert.Equal(t, "address.city: value is required", fc.Err().Error())
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		// --- Given ---
+		fc := xrr.NewFieldCollector[edTest]()
+
+		// --- When ---
+		Struct(fc, "address", nil, validateAddress)
+
+		// --- Then ---
+		assert.NoError(t, fc.Err())
+	})
+}
+
+func Test_Slice(t *testing.T) {
+	// --- Given ---
+	fc := xrr.NewFieldCollector[edTest]()
+	items := []tItem{{Name: "a", Qty: 1}, {Name: "", Qty: 0}}
+
+	// --- When ---
+	Slice(fc, "items", items, func(fc *xrr.FieldCollector[edTest], item tItem) {
+		Field(fc, "name", item.Name, Required)
+		Field(fc, "qty", item.Qty, Min(1))
+	})
+
+	// --- Then ---
+	err := fc.Err()
+	want := "items[1].name: value is required; items[1].qty: value must be at least 1"
+	assert.Equal(t, want, err.Error())
+	wantJSON := `{
+		"items[1].name": {"code": "ECRequired", "error": "value is required"},
+		"items[1].qty": {"code": "ECMin", "error": "value must be at least 1", "meta": {"min": 1}}
+	}`
+	assert.JSON(t, wantJSON, string(must.Value(json.Marshal(err))))
+}
+
+func Test_Check(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		// --- When ---
+		err := Check("user@example.com", Required, Email)
+
+		// --- Then ---
+		assert.NoError(t, err)
+	})
+
+	t.Run("single error", func(t *testing.T) {
+		// --- When ---
+		err := Check("user", Required, Email)
+
+		// --- Then ---
+		assert.Equal(t, ECEmail, xrr.GetCode(err))
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		// --- When ---
+		err := Check("x", MinLength(2), OneOf("abc"))
+
+		// --- Then ---
+		assert.Equal(t, []string{ECMinLength, ECOneOf}, xrr.GetCodes(err))
+	})
+
+	t.Run("required stops checking", func(t *testing.T) {
+		// --- When ---
+		err := Check("", Required, Email)
+
+		// --- Then ---
+		assert.Equal(t, []string{ECRequired}, xrr.GetCodes(err))
+	})
+}
+
+func Test_isEmpty_tabular(t *testing.T) {
+	var nilAny any
+	tt := []struct {
+		testN string
+
+		v    any
+		want bool
+	}{
+		{"empty string", "", true},
+		{"string", "a", false},
+		{"nil slice", []int(nil), true},
+		{"empty slice", []int{}, true},
+		{"slice", []int{1}, false},
+		{"empty map", map[string]int{}, true},
+		{"nil pointer", (*tAddress)(nil), true},
+		{"pointer", &tAddress{}, false},
+		{"nil interface", nilAny, true},
+		{"zero int", 0, false},
+		{"zero struct", tAddress{}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := isEmpty(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_metaValue_tabular(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	type tStr string
+
+	tt := []struct {
+		testN string
+
+		v    any
+		want any
+	}{
+		{"int", 1, 1},
+		{"int8", int8(-2), -2},
+		{"int64", int64(3), 3},
+		{"uint", uint(4), 4},
+		{"uint64 overflow", uint64(math.MaxUint64), uint64(math.MaxUint64)},
+		{"float32", float32(1.5), 1.5},
+		{"float64", 2.5, 2.5},
+		{"string", "a", "a"},
+		{"named string", tStr("b"), "b"},
+		{"bool", true, true},
+		{"time", now, now},
+		{"duration", time.Second, time.Second},
+		{"other", []byte("x"), "[120]"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := metaValue(tc.v)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_metaValues(t *testing.T) {
+	t.Run("ints", func(t *testing.T) {
+		// --- When ---
+		have := metaValues([]int8{1, 2})
+
+		// --- Then ---
+		assert.Equal(t, []int{1, 2}, have)
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		// --- When ---
+		have := metaValues([]string{"a", "b"})
+
+		// --- Then ---
+		assert.Equal(t, []string{"a", "b"}, have)
+	})
+
+	t.Run("floats", func(t *testing.T) {
+		// --- When ---
+		have := metaValues([]float64{1.5, 2})
+
+		// --- Then ---
+		assert.Equal(t, []string{"1.5", "2"}, have)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// --- When ---
+		have := metaValues([]int{})
+
+		// --- Then ---
+		assert.Equal(t, []string{}, have)
+	})
+}
+
+func Test_fail(t *testing.T) {
+	// --- When ---
+	err := fail(ECRange, map[string]any{MetaMin: 1, MetaMax: 2})
+
+	// --- Then ---
+	assert.Equal(t, "value must be between 1 and 2", err.Error())
+	assert.Equal(t, ECRange, xrr.GetCode(err))
+	assert.Equal(t, "validate.edValidate", xrr.GetDomain(err))
+	assert.Equal(t, templates[ECRange], xrr.GetTemplate(err))
+	assert.False(t, errors.Is(err, fail(ECRange, nil)))
+}
@@ -35,6 +35,48 @@ type Metadater interface {
 	MetaAll() map[string]any
 }
 
+// Stacker is the interface that wraps the ErrorStack method.
+type Stacker interface {
+	// ErrorStack returns the call stack recorded when the error was created.
+	// Returns nil if no stack was recorded. It does not include stacks from
+	// wrapped errors, to retrieve it recursively use [GetStack] instead.
+	ErrorStack() Stack
+}
+
+// Templater is the interface that wraps the ErrorTemplate method.
+type Templater interface {
+	// ErrorTemplate returns the message template the error message was
+	// rendered from. Returns an empty string if the error was not created
+	// from a template. See [GetTemplate].
+	ErrorTemplate() string
+}
+
+// DomainNamer is the interface a domain marker type may implement to name the
+// error domain. The name is used instead of the marker type name, for
+// example, by [GenericError.ErrorDomain], [Register], and is included in the
+// JSON representation of the errors (see [RegisterDomain]).
+type DomainNamer interface {
+	// DomainName returns the error domain name. It is called on the zero
+	// value of the marker type.
+	DomainName() string
+}
+
+// Domainer is the interface implemented by errors belonging to an error
+// domain.
+type Domainer interface {
+	// ErrorDomain returns the error domain name.
+	ErrorDomain() string
+}
+
+// DomainError is the interface implemented by [GenericError] instances of any
+// domain. It is the type of the errors returned by [DecodeJSON].
+type DomainError interface {
+	error
+	Coder
+	Domainer
+	Metadater
+}
+
 // WrapUsing annotates err with a code and optional metadata in domain T,
 // without adding a new message. The returned error's Error() is identical to
 // err.Error().
@@ -46,14 +88,21 @@ type Metadater interface {
 // To annotate with a new message as well, obtain a constructor with [ErrorFunc]
 // and pass [WithCause].
 func WrapUsing[T Domain](err error, opts ...Option) error {
+	return wrapUsing[T](1, err, opts...)
+}
+
+// wrapUsing implements [WrapUsing]. The skip is the number of stack frames
+// between the caller and this function.
+func wrapUsing[T Domain](skip int, err error, opts ...Option) error {
 	if err == nil || isNil(err) {
 		return nil
 	}
 	ops := Options{code: GetCode(err)}.Set(opts...)
 	return &GenericError[T]{
-		code: ops.code,
-		meta: ops.meta,
-		err:  err,
+		code:  ops.code,
+		meta:  ops.meta,
+		err:   err,
+		stack: callers(skip, ops.stack),
 	}
 }
 
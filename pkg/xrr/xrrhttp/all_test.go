@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrrhttp
+
+import (
+	"errors"
+)
+
+// tErrMarshalJSON represents an error which fails to marshal to JSON.
+type tErrMarshalJSON struct{}
+
+func (tErrMarshalJSON) Error() string                { return "test error" }
+func (tErrMarshalJSON) MarshalJSON() ([]byte, error) { return nil, errors.New("e") }
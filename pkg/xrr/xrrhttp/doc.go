@@ -0,0 +1,5 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+// Package xrrhttp provides helpers for writing xrr errors as HTTP responses.
+package xrrhttp
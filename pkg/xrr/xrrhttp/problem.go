@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrrhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+// ContentTypeProblem is the media type of the RFC 9457 Problem Details JSON
+// documents.
+const ContentTypeProblem = "application/problem+json"
+
+// Problem represents the RFC 9457 Problem Details document.
+type Problem struct {
+	// URI reference identifying the problem type.
+	Type string `json:"type,omitempty"`
+
+	// Short, human-readable summary of the problem type.
+	Title string `json:"title,omitempty"`
+
+	// HTTP status code.
+	Status int `json:"status,omitempty"`
+
+	// Human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+
+	// URI reference identifying the specific occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+
+	// Extension member with the error code.
+	Code string `json:"code,omitempty"`
+
+	// Extension member with the error metadata.
+	Meta map[string]any `json:"meta,omitempty"`
+
+	// Extension member with the field errors and the other causes.
+	Errors []ProblemError `json:"errors,omitempty"`
+}
+
+// ProblemError represents an entry in the [Problem.Errors] extension member.
+type ProblemError struct {
+	// JSON Pointer (RFC 6901) in the URI fragment representation to the
+	// request body member the error relates to. Empty for errors which are
+	// not field errors.
+	Pointer string `json:"pointer,omitempty"`
+
+	// Error message.
+	Detail string `json:"detail"`
+
+	// Error code.
+	Code string `json:"code"`
+
+	// Error metadata.
+	Meta map[string]any `json:"meta,omitempty"`
+}
+
+// WithProblemType is an option setting the base URI of the [Problem.Type].
+// The type is the base followed by the error code. When not set, the type
+// is omitted, which is equivalent to "about:blank".
+func WithProblemType(base string) Option {
+	return func(wr *Writer) { wr.typeBase = base }
+}
+
+// Problem returns the Problem Details representation of the error. The lead
+// error is chosen the same way as in the [xrr.Envelope]:
+//   - Title is the [xrr.CodeInfo.Message] of the lead error code from the
+//     default [xrr.Registry], or the status text when not declared.
+//   - Detail is the lead error message.
+//   - Status is the status returned by [Writer.Status].
+//   - Code is the lead error code and Meta its [xrr.GetMeta] metadata.
+//   - Errors are the field errors, with pointers to the fields, or the other
+//     causes, without pointers. Fields with multiple errors have an entry
+//     for each of them.
+func (wr *Writer) Problem(err error) Problem {
+	lead, fields, causes := split(err)
+	code := xrr.GetCode(lead)
+	prb := Problem{
+		Status: wr.Status(err),
+		Detail: lead.Error(),
+		Code:   code,
+		Meta:   xrr.GetMeta(lead),
+	}
+	prb.Title = http.StatusText(prb.Status)
+	if info, ok := xrr.Lookup(code); ok && info.Message != "" {
+		prb.Title = info.Message
+	}
+	if wr.typeBase != "" {
+		prb.Type = wr.typeBase + code
+	}
+	if fields != nil {
+		flat := xrr.NewFields[edHTTP](fields.ErrorFields()).Flatten()
+		for _, name := range xrr.FieldNames(flat) {
+			fe := flat.Get(name)
+			if fe == nil {
+				continue
+			}
+			for _, e := range xrr.SplitFieldErrors(fe) {
+				entry := problemError(e)
+				entry.Pointer = pointer(name)
+				prb.Errors = append(prb.Errors, entry)
+			}
+		}
+	}
+	for _, cause := range causes {
+		prb.Errors = append(prb.Errors, problemError(cause))
+	}
+	return prb
+}
+
+// WriteProblem writes the error as the Problem Details JSON document with
+// the status returned by [Writer.Status]. It is a no-op when err is nil.
+func (wr *Writer) WriteProblem(w http.ResponseWriter, r *http.Request, err error) {
+	if err == nil {
+		return
+	}
+	prb := wr.Problem(err)
+	data, e := json.Marshal(prb)
+	if e != nil {
+		prb = Problem{
+			Status: http.StatusInternalServerError,
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Code:   ECInternal,
+		}
+		data, _ = json.Marshal(prb)
+		err = errors.Join(err, e)
+	}
+	w.Header().Set("Content-Type", ContentTypeProblem)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(prb.Status)
+	_, _ = w.Write(data)
+	if wr.hook != nil {
+		wr.hook(r, prb.Status, err)
+	}
+}
+
+// WriteProblem writes the error using the default [Writer]. See
+// [Writer.WriteProblem] for details.
+func WriteProblem(w http.ResponseWriter, r *http.Request, err error) {
+	std.WriteProblem(w, r, err)
+}
+
+// DecodeProblem decodes the Problem Details JSON document to an error tree.
+// The decoded error is an [xrr.Error] with the message set to the detail
+// (or the title when the detail is empty), the code and metadata taken from
+// the extension members, and the cause built from the "errors" member.
+// Entries with pointers become an [xrr.FieldErrors] cause with field names
+// in the notation used by its keys, for example, "items[3].price", the other
+// entries are joined with it. Entries with the same pointer are all kept
+// (see [xrr.GenericFields.AddField]).
+//
+// Returns an error when the document is not valid JSON or [xrr.ErrInvJSON]
+// when both the detail and title are empty.
+func DecodeProblem(data []byte) (*xrr.Error, error) {
+	var prb Problem
+	if err := json.Unmarshal(data, &prb); err != nil {
+		return nil, err
+	}
+	msg := prb.Detail
+	if msg == "" {
+		msg = prb.Title
+	}
+	if msg == "" {
+		return nil, xrr.ErrInvJSON
+	}
+
+	var causes []error
+	var fields *xrr.FieldErrors
+	for _, entry := range prb.Errors {
+		e := xrr.New(entry.Detail, entry.Code, xrr.WithMeta(entry.Meta))
+		if entry.Pointer == "" {
+			causes = append(causes, e)
+			continue
+		}
+		if fields == nil {
+			fields = xrr.NewFieldErrors(nil)
+		}
+		fields.AddField(field(entry.Pointer), e)
+	}
+	if fields != nil {
+		causes = slices.Insert(causes, 0, error(fields))
+	}
+
+	opts := []xrr.Option{xrr.WithMeta(prb.Meta)}
+	if cause := xrr.Join(causes...); cause != nil {
+		opts = append(opts, xrr.WithCause(cause))
+	}
+	code := xrr.DefaultCode(xrr.ECGeneric, prb.Code)
+	return xrr.New(msg, code, opts...).(*xrr.Error), nil // nolint: errorlint
+}
+
+// split splits the error the same way as [xrr.Envelope] does into the lead
+// error, the field errors, and the other causes.
+func split(err error) (error, xrr.Fielder, []error) {
+	env, _ := xrr.Enclose(err).(xrr.Envelope) // nolint: errorlint
+	lead, cause := env.Lead(), env.Unwrap()
+	if fls, ok := cause.(xrr.Fielder); ok {
+		if lead == nil {
+			lead = xrr.ErrFields
+		}
+		return lead, fls, nil
+	}
+	if xrr.IsJoined(cause) {
+		ers := xrr.Split(cause)
+		if lead == nil && len(ers) > 0 {
+			return ers[0], nil, ers[1:]
+		}
+		return lead, nil, ers
+	}
+	if lead == nil {
+		return cause, nil, nil
+	}
+	return lead, nil, []error{cause}
+}
+
+// problemError returns the [ProblemError] representation of the error.
+func problemError(err error) ProblemError {
+	return ProblemError{
+		Detail: err.Error(),
+		Code:   xrr.GetCode(err),
+		Meta:   xrr.GetMeta(err),
+	}
+}
+
+// pointer returns the JSON Pointer in the URI fragment representation for
+// the field name or path (see [xrr.ParseFieldPath]), for example,
+// "#/items/3/price" for "items[3].price".
+func pointer(field string) string {
+	return "#" + xrr.ParseFieldPath(field).Pointer()
+}
+
+// field returns the field name, with the indexes in square brackets, for the
+// JSON Pointer in the string or the URI fragment representation, for
+// example, "items[3].price" for "#/items/3/price".
+func field(pointer string) string {
+	pointer = strings.TrimPrefix(pointer, "#")
+	if !strings.HasPrefix(pointer, "/") {
+		pointer = "/" + pointer
+	}
+	return xrr.ParseFieldPath(pointer).String()
+}
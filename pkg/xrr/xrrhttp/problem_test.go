@@ -0,0 +1,437 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrrhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+	"github.com/ctx42/testing/pkg/must"
+
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+func Test_WithProblemType(t *testing.T) {
+	// --- Given ---
+	wr := &Writer{}
+
+	// --- When ---
+	WithProblemType("https://example.com/problems/")(wr)
+
+	// --- Then ---
+	assert.Equal(t, "https://example.com/problems/", wr.typeBase)
+}
+
+func Test_Writer_Problem(t *testing.T) {
+	t.Run("single error", func(t *testing.T) {
+		// --- Given ---
+		err := xrr.New("user not found", "ECode", xrr.Meta().Int("A", 1).Option())
+		wr := NewWriter(WithStatus("ECode", http.StatusNotFound))
+
+		// --- When ---
+		have := wr.Problem(err)
+
+		// --- Then ---
+		want := Problem{
+			Title:  "Not Found",
+			Status: http.StatusNotFound,
+			Detail: "user not found",
+			Code:   "ECode",
+			Meta:   map[string]any{"A": 1},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("type and title from registry", func(t *testing.T) {
+		// --- Given ---
+		err := xrr.Wrap(errors.New("bad syntax"), xrr.WithCode(xrr.ECInvJSON))
+		wr := NewWriter(WithProblemType("https://example.com/problems/"))
+
+		// --- When ---
+		have := wr.Problem(err)
+
+		// --- Then ---
+		want := Problem{
+			Type:   "https://example.com/problems/ECInvJSON",
+			Title:  "invalid JSON",
+			Status: http.StatusBadRequest,
+			Detail: "bad syntax",
+			Code:   xrr.ECInvJSON,
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		// --- Given ---
+		err := xrr.NewFieldErrors(map[string]error{
+			"email": xrr.New("invalid email", "ECEmail"),
+			"user": xrr.NewFieldErrors(map[string]error{
+				"a/b": errors.New("invalid"),
+			}),
+			"nil": nil,
+		})
+
+		// --- When ---
+		have := NewWriter().Problem(err)
+
+		// --- Then ---
+		want := Problem{
+			Title:  "fields error",
+			Status: http.StatusUnprocessableEntity,
+			Detail: "fields error",
+			Code:   xrr.ECFields,
+			Errors: []ProblemError{
+				{Pointer: "#/email", Detail: "invalid email", Code: "ECEmail"},
+				{Pointer: "#/user/a~1b", Detail: "invalid", Code: xrr.ECGeneric},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+
+	t.Run("indexed fields", func(t *testing.T) {
+		// --- Given ---
+		fs := xrr.NewFieldErrors(nil)
+		fs.SetPath(xrr.Path().Field("items").Index(3).Field("price"), xrr.New("negative", "ECPrice"))
+		fs.SetPath(xrr.Path().Field("tags").Index(0), xrr.New("empty", "ECTag"))
+
+		// --- When ---
+		have := NewWriter().Problem(fs)
+
+		// --- Then ---
+		want := []ProblemError{
+			{Pointer: "#/items/3/price", Detail: "negative", Code: "ECPrice"},
+			{Pointer: "#/tags/0", Detail: "empty", Code: "ECTag"},
+		}
+		assert.Equal(t, want, have.Errors)
+	})
+
+	t.Run("field with multiple errors", func(t *testing.T) {
+		// --- Given ---
+		fs := xrr.NewFieldErrors(nil)
+		fs.AddField("password", xrr.New("too short", "ECShort"))
+		fs.AddField("password", xrr.New("no digit", "ECDigit"))
+
+		// --- When ---
+		have := NewWriter().Problem(fs)
+
+		// --- Then ---
+		want := []ProblemError{
+			{Pointer: "#/password", Detail: "too short", Code: "ECShort"},
+			{Pointer: "#/password", Detail: "no digit", Code: "ECDigit"},
+		}
+		assert.Equal(t, want, have.Errors)
+	})
+
+	t.Run("joined error set for field", func(t *testing.T) {
+		// --- Given ---
+		fe := errors.Join(errors.New("em0"), errors.New("em1"))
+		fs := xrr.NewFieldErrors(map[string]error{"f": fe})
+
+		// --- When ---
+		have := NewWriter().Problem(fs)
+
+		// --- Then ---
+		want := []ProblemError{
+			{Pointer: "#/f", Detail: "em0\nem1", Code: xrr.ECGeneric},
+		}
+		assert.Equal(t, want, have.Errors)
+	})
+
+	t.Run("joined errors", func(t *testing.T) {
+		// --- Given ---
+		cause := errors.Join(xrr.New("msg 0", "EC0"), xrr.New("msg 1", "EC1"))
+		err := xrr.Enclose(cause, xrr.New("lead", "ECLead"))
+
+		// --- When ---
+		have := NewWriter().Problem(err)
+
+		// --- Then ---
+		want := Problem{
+			Title:  "Internal Server Error",
+			Status: http.StatusInternalServerError,
+			Detail: "lead",
+			Code:   "ECLead",
+			Errors: []ProblemError{
+				{Detail: "msg 0", Code: "EC0"},
+				{Detail: "msg 1", Code: "EC1"},
+			},
+		}
+		assert.Equal(t, want, have)
+	})
+}
+
+func Test_Writer_WriteProblem(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- Given ---
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// --- When ---
+		NewWriter().WriteProblem(w, r, nil)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "", w.Body.String())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		var hStatus int
+		hook := func(_ *http.Request, status int, _ error) { hStatus = status }
+		wr := NewWriter(WithErrorHook(hook))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		err := xrr.NewFieldError("age", xrr.New("too young", "ECAge"))
+
+		// --- When ---
+		wr.WriteProblem(w, r, err)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, http.StatusUnprocessableEntity, hStatus)
+		wCT := "application/problem+json"
+		assert.Equal(t, wCT, w.Header().Get("Content-Type"))
+		want := `{
+			"title": "fields error",
+			"status": 422,
+			"detail": "fields error",
+			"code": "ECFields",
+			"errors": [
+				{"pointer": "#/age", "detail": "too young", "code": "ECAge"}
+			]
+		}`
+		assert.JSON(t, want, w.Body.String())
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		// --- Given ---
+		var hErr error
+		hook := func(_ *http.Request, _ int, err error) { hErr = err }
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		meta := xrr.Meta().Float64("A", math.NaN()).Option()
+		err := xrr.New("msg", "ECode", meta)
+
+		// --- When ---
+		NewWriter(WithErrorHook(hook)).WriteProblem(w, r, err)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		want := `{
+			"title": "Internal Server Error",
+			"status": 500,
+			"code": "ECInternal"
+		}`
+		assert.JSON(t, want, w.Body.String())
+		assert.ErrorIs(t, err, hErr)
+		assert.ErrorContain(t, "unsupported value", hErr)
+	})
+}
+
+func Test_WriteProblem(t *testing.T) {
+	// --- Given ---
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	// --- When ---
+	WriteProblem(w, r, xrr.ErrInvJSON)
+
+	// --- Then ---
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	want := `{
+		"title": "invalid JSON",
+		"status": 400,
+		"detail": "invalid JSON",
+		"code": "ECInvJSON"
+	}`
+	assert.JSON(t, want, w.Body.String())
+}
+
+func Test_DecodeProblem(t *testing.T) {
+	t.Run("minimal", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"title": "Not Found", "status": 404}`)
+
+		// --- When ---
+		have, err := DecodeProblem(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.ErrorEqual(t, "Not Found", have)
+		assert.Equal(t, xrr.ECGeneric, xrr.GetCode(have))
+		assert.Nil(t, errors.Unwrap(have))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		// --- Given ---
+		meta := xrr.Meta().Str("user", "u-1").Option()
+		src := xrr.NewFieldErrors(map[string]error{
+			"email": xrr.New("invalid email", "ECEmail"),
+			"address": xrr.NewFieldErrors(map[string]error{
+				"city": xrr.New("invalid city", "ECCity", meta),
+			}),
+		})
+		err := xrr.Enclose(src, xrr.New("invalid user", "ECUser"))
+		data := must.Value(json.Marshal(NewWriter().Problem(err)))
+
+		// --- When ---
+		have, err := DecodeProblem(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		assert.Equal(t, "ECUser", xrr.GetCode(have))
+		wCodes := []string{"ECUser", "ECCity", "ECEmail"}
+		assert.Equal(t, wCodes, xrr.GetCodes(have))
+		assert.True(t, xrr.IsCode(have, "ECCity"))
+
+		fls := errors.Unwrap(have)
+		assert.Equal(t, []string{"address.city", "email"}, xrr.FieldNames(fls))
+		fe := xrr.GetFieldError(fls, "address.city")
+		assert.ErrorEqual(t, "invalid city", fe)
+		user, _ := xrr.GetStr(fe, "user")
+		assert.Equal(t, "u-1", user)
+	})
+
+	t.Run("round trip indexed fields", func(t *testing.T) {
+		// --- Given ---
+		src := xrr.NewFieldErrors(nil)
+		src.SetPath(xrr.Path().Field("items").Index(3).Field("price"), xrr.New("negative", "ECPrice"))
+		data := must.Value(json.Marshal(NewWriter().Problem(src)))
+
+		// --- When ---
+		have, err := DecodeProblem(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		fls := errors.Unwrap(have)
+		assert.Equal(t, []string{"items[3].price"}, xrr.FieldNames(fls))
+		fe := xrr.GetFieldError(fls, "items[3].price")
+		assert.Equal(t, "ECPrice", xrr.GetCode(fe))
+	})
+
+	t.Run("round trip field with multiple errors", func(t *testing.T) {
+		// --- Given ---
+		src := xrr.NewFieldErrors(nil)
+		src.AddField("password", xrr.New("too short", "ECShort"))
+		src.AddField("password", xrr.New("no digit", "ECDigit"))
+		data := must.Value(json.Marshal(NewWriter().Problem(src)))
+
+		// --- When ---
+		have, err := DecodeProblem(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		fls := errors.Unwrap(have)
+		assert.Equal(t, []string{"password"}, xrr.FieldNames(fls))
+		ers := xrr.SplitFieldErrors(xrr.GetFieldError(fls, "password"))
+		assert.Len(t, 2, ers)
+		assert.Equal(t, "ECShort", xrr.GetCode(ers[0]))
+		assert.Equal(t, "ECDigit", xrr.GetCode(ers[1]))
+	})
+
+	t.Run("errors without pointers", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{
+			"detail": "lead",
+			"code": "ECLead",
+			"meta": {"A": 1},
+			"errors": [
+				{"detail": "msg 0", "code": "EC0"},
+				{"pointer": "#/f", "detail": "msg 1", "code": "EC1"},
+				{"detail": "msg 2", "code": "EC2"}
+			]
+		}`)
+
+		// --- When ---
+		have, err := DecodeProblem(data)
+
+		// --- Then ---
+		assert.NoError(t, err)
+		wCodes := []string{"ECLead", "EC1", "EC0", "EC2"}
+		assert.Equal(t, wCodes, xrr.GetCodes(have))
+		a, _ := xrr.GetFloat64(have, "A")
+		assert.Equal(t, 1.0, a)
+	})
+
+	t.Run("error - no detail and title", func(t *testing.T) {
+		// --- Given ---
+		data := []byte(`{"status": 500}`)
+
+		// --- When ---
+		have, err := DecodeProblem(data)
+
+		// --- Then ---
+		assert.ErrorIs(t, xrr.ErrInvJSON, err)
+		assert.Nil(t, have)
+	})
+
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		// --- When ---
+		have, err := DecodeProblem([]byte(`{!!!}`))
+
+		// --- Then ---
+		var target *json.SyntaxError
+		assert.ErrorAs(t, &target, err)
+		assert.Nil(t, have)
+	})
+}
+
+func Test_pointer_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		field string
+		want  string
+	}{
+		{"simple", "a", "#/a"},
+		{"nested", "a.b.c", "#/a/b/c"},
+		{"escaped", "a~b/c", "#/a~0b~1c"},
+		{"index", "items[3].price", "#/items/3/price"},
+		{"nested indexes", "grid[1][0]", "#/grid/1/0"},
+		{"empty", "", "#"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := pointer(tc.field)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_field_tabular(t *testing.T) {
+	tt := []struct {
+		testN string
+
+		pointer string
+		want    string
+	}{
+		{"fragment", "#/a", "a"},
+		{"string", "/a", "a"},
+		{"nested", "#/a/b/c", "a.b.c"},
+		{"escaped", "#/a~0b~1c", "a~b/c"},
+		{"index", "#/items/3/price", "items[3].price"},
+		{"nested indexes", "#/grid/1/0", "grid[1][0]"},
+		{"no slash", "#a", "a"},
+		{"root", "#", ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- When ---
+			have := field(tc.pointer)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
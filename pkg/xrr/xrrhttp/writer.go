@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrrhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+// ECPanic represents error code for errors created from panics recovered by
+// [Writer.Handler].
+const ECPanic = "ECPanic"
+
+// ECInternal represents error code for errors written when the original error
+// cannot be marshaled to JSON.
+const ECInternal = "ECInternal"
+
+// edHTTP is the marker type for the package's error domain.
+type edHTTP struct{}
+
+// Error constructor function for the package domain.
+var newError = xrr.ErrorFunc[edHTTP]()
+
+// ErrPanic is the error written to the client when a panic is recovered by
+// [Writer.Handler]. Details of the panic are never sent to the client, they
+// are passed to the hook set with [WithErrorHook].
+var ErrPanic = newError("internal server error", ECPanic)
+
+// errInternal is the error written to the client when the error cannot be
+// marshaled to JSON. Details of the marshal error are never sent to the
+// client, they are passed to the hook set with [WithErrorHook].
+var errInternal = newError("internal server error", ECInternal)
+
+func init() {
+	xrr.Register[edHTTP](xrr.CodeInfo{
+		Code:        ECPanic,
+		Description: "Panic recovered in HTTP handler.",
+		Message:     ErrPanic.Error(),
+		Status:      http.StatusInternalServerError,
+	})
+	xrr.Register[edHTTP](xrr.CodeInfo{
+		Code:        ECInternal,
+		Description: "Error which cannot be marshaled to JSON.",
+		Message:     errInternal.Error(),
+		Status:      http.StatusInternalServerError,
+	})
+	xrr.RegisterSentinel(ErrPanic)
+	xrr.RegisterSentinel(errInternal)
+}
+
+// std is the [Writer] used by [WriteError] and [Handler].
+var std = NewWriter()
+
+// HandlerFunc represents an HTTP handler returning an error.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// Option represents an option for configuring [Writer] instances.
+type Option func(*Writer)
+
+// WithStatus is an option setting the HTTP status used for the error code.
+func WithStatus(code string, status int) Option {
+	return func(wr *Writer) { wr.statuses[code] = status }
+}
+
+// WithStatuses is an option setting HTTP statuses for the error codes.
+func WithStatuses(statuses map[string]int) Option {
+	return func(wr *Writer) { maps.Copy(wr.statuses, statuses) }
+}
+
+// WithErrorHook is an option setting a function called for every error
+// written by the [Writer] with the HTTP status it was written with. For
+// recovered panics, the hook receives an error with the [ECPanic] code, the
+// [ErrPanic] message followed by the panic value, and the recorded stack. For errors which
+// cannot be marshaled to JSON, the hook receives the error joined with the
+// marshal error.
+func WithErrorHook(fn func(r *http.Request, status int, err error)) Option {
+	return func(wr *Writer) { wr.hook = fn }
+}
+
+// DefaultStatuses returns the default mapping of error codes to HTTP
+// statuses used by [Writer] instances.
+func DefaultStatuses() map[string]int {
+	return map[string]int{
+		xrr.ECFields:       http.StatusUnprocessableEntity,
+		xrr.ECInvJSON:      http.StatusBadRequest,
+		xrr.ECInvJSONError: http.StatusBadRequest,
+		ECPanic:            http.StatusInternalServerError,
+		ECInternal:         http.StatusInternalServerError,
+	}
+}
+
+// Writer writes errors as JSON [xrr.Envelope] responses with HTTP statuses
+// chosen based on error codes.
+type Writer struct {
+	statuses map[string]int                               // Code to status.
+	hook     func(r *http.Request, status int, err error) // Error hook.
+	typeBase string                                       // Problem type base.
+}
+
+// NewWriter returns a new instance of [Writer] using [DefaultStatuses]
+// mapping amended by the provided options.
+func NewWriter(opts ...Option) *Writer {
+	wr := &Writer{statuses: DefaultStatuses()}
+	for _, opt := range opts {
+		opt(wr)
+	}
+	return wr
+}
+
+// Status returns the HTTP status for the error. The candidate codes are
+// checked in the following order:
+//   - the code of the lead error when err is an [xrr.Envelope] with one,
+//   - [xrr.ECFields] when there is no lead error and the cause implements
+//     [xrr.Fielder],
+//   - codes returned by [xrr.GetCodes] for the cause.
+//
+// For each candidate, the status is taken from the writer's mapping and, if
+// not present there, from the [xrr.CodeInfo.Status] in the default
+// [xrr.Registry]. The [xrr.ECGeneric] candidates are skipped because they
+// represent errors without a code. Returns [http.StatusInternalServerError]
+// when none of the candidates has a status.
+func (wr *Writer) Status(err error) int {
+	env, _ := xrr.Enclose(err).(xrr.Envelope) // nolint: errorlint
+	var codes []string
+	if lead := env.Lead(); lead != nil {
+		codes = append(codes, xrr.GetCode(lead))
+	} else if xrr.GetFields(env.Unwrap()) != nil {
+		codes = append(codes, xrr.ECFields)
+	}
+	codes = append(codes, xrr.GetCodes(env.Unwrap())...)
+	for _, code := range codes {
+		if code == xrr.ECGeneric {
+			continue
+		}
+		if status, ok := wr.statuses[code]; ok {
+			return status
+		}
+		if info, ok := xrr.Lookup(code); ok && info.Status != 0 {
+			return info.Status
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+// WriteError writes the error enclosed in the [xrr.Envelope] as the JSON
+// response with the status returned by [Writer.Status]. It is a no-op when
+// err is nil. When the response headers were already written, the status
+// cannot be changed.
+func (wr *Writer) WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	if err == nil {
+		return
+	}
+	status, e := wr.write(w, wr.Status(err), xrr.Enclose(err))
+	if e != nil {
+		err = errors.Join(err, e)
+	}
+	if wr.hook != nil {
+		wr.hook(r, status, err)
+	}
+}
+
+// Handler returns [http.Handler] calling fn and writing the returned error
+// with [Writer.WriteError]. Panics in fn are recovered and written as
+// [ErrPanic] with the [http.StatusInternalServerError] status, except for
+// [http.ErrAbortHandler] which is re-panicked.
+func (wr *Writer) Handler(fn HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler { // nolint: errorlint
+				panic(v)
+			}
+			_, _ = wr.write(w, http.StatusInternalServerError, ErrPanic)
+			if wr.hook != nil {
+				wr.hook(r, http.StatusInternalServerError, panicError(v))
+			}
+		}()
+		if err := fn(w, r); err != nil {
+			wr.WriteError(w, r, err)
+		}
+	})
+}
+
+// write writes the JSON representation of the error with the given status.
+// When the error cannot be marshaled, it writes [errInternal] with the
+// [http.StatusInternalServerError] status instead. Returns the written
+// status and the marshal error.
+func (wr *Writer) write(w http.ResponseWriter, status int, err error) (int, error) {
+	data, e := json.Marshal(err)
+	if e != nil {
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(xrr.Enclose(errInternal))
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+	return status, e
+}
+
+// WriteError writes the error using the default [Writer]. See
+// [Writer.WriteError] for details.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	std.WriteError(w, r, err)
+}
+
+// Handler returns [http.Handler] using the default [Writer]. See
+// [Writer.Handler] for details.
+func Handler(fn HandlerFunc) http.Handler { return std.Handler(fn) }
+
+// panicError returns an error with the [ErrPanic] message and code describing
+// the recovered panic value. The panic value is the error's cause.
+func panicError(v any) error {
+	cause := fmt.Errorf("panic: %v", v)
+	if err, ok := v.(error); ok {
+		cause = fmt.Errorf("panic: %w", err)
+	}
+	msg := "internal server error"
+	return newError(msg, ECPanic, xrr.WithCause(cause), xrr.WithStack())
+}
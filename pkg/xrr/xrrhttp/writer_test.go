@@ -0,0 +1,366 @@
+// SPDX-FileCopyrightText: (c) 2026 Rafal Zajac
+// SPDX-License-Identifier: MIT
+
+package xrrhttp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ctx42/testing/pkg/assert"
+
+	"github.com/ctx42/xrr/pkg/xrr"
+)
+
+func Test_WithStatus(t *testing.T) {
+	// --- Given ---
+	wr := &Writer{statuses: map[string]int{}}
+
+	// --- When ---
+	WithStatus("ECode", http.StatusConflict)(wr)
+
+	// --- Then ---
+	assert.Equal(t, map[string]int{"ECode": http.StatusConflict}, wr.statuses)
+}
+
+func Test_WithStatuses(t *testing.T) {
+	// --- Given ---
+	wr := &Writer{statuses: map[string]int{"A": 400, "B": 401}}
+	m := map[string]int{"B": 402, "C": 403}
+
+	// --- When ---
+	WithStatuses(m)(wr)
+
+	// --- Then ---
+	want := map[string]int{"A": 400, "B": 402, "C": 403}
+	assert.Equal(t, want, wr.statuses)
+}
+
+func Test_WithErrorHook(t *testing.T) {
+	// --- Given ---
+	wr := &Writer{}
+	fn := func(*http.Request, int, error) {}
+
+	// --- When ---
+	WithErrorHook(fn)(wr)
+
+	// --- Then ---
+	assert.Same(t, fn, wr.hook)
+}
+
+func Test_DefaultStatuses(t *testing.T) {
+	// --- When ---
+	have := DefaultStatuses()
+
+	// --- Then ---
+	want := map[string]int{
+		xrr.ECFields:       http.StatusUnprocessableEntity,
+		xrr.ECInvJSON:      http.StatusBadRequest,
+		xrr.ECInvJSONError: http.StatusBadRequest,
+		ECPanic:            http.StatusInternalServerError,
+		ECInternal:         http.StatusInternalServerError,
+	}
+	assert.Equal(t, want, have)
+}
+
+func Test_NewWriter(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// --- When ---
+		have := NewWriter()
+
+		// --- Then ---
+		assert.Equal(t, DefaultStatuses(), have.statuses)
+		assert.Nil(t, have.hook)
+	})
+
+	t.Run("with options", func(t *testing.T) {
+		// --- When ---
+		have := NewWriter(WithStatus(xrr.ECFields, http.StatusBadRequest))
+
+		// --- Then ---
+		assert.Equal(t, http.StatusBadRequest, have.statuses[xrr.ECFields])
+	})
+}
+
+func Test_Writer_Status_tabular(t *testing.T) {
+	fields := xrr.NewFieldError("f", xrr.New("msg", "ECField"))
+
+	tt := []struct {
+		testN string
+
+		err  error
+		want int
+	}{
+		{"std error", errors.New("msg"), 500},
+		{"code not mapped", xrr.New("msg", "ECode"), 500},
+		{"mapped code", xrr.New("msg", "ECMapped"), 409},
+		{"default mapping", xrr.ErrInvJSON, 400},
+		{"fields", fields, 422},
+		{"mapped field code", xrr.Enclose(fields, xrr.New("m", "ECLead")), 418},
+		{"lead code", xrr.Enclose(xrr.New("m", "ECMapped"), xrr.ErrInvJSON), 400},
+		{"cause code", xrr.Enclose(xrr.New("m", "ECMapped"), errors.New("m")), 409},
+		{"wrapped", xrr.Wrap(xrr.New("m", "ECMapped"), xrr.WithCode("EC")), 409},
+		{"joined", errors.Join(errors.New("m"), xrr.New("m", "ECMapped")), 409},
+		{"registry", xrr.New("msg", xrr.ECGeneric), 500},
+		{"registry declared code", ErrPanic, 500},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testN, func(t *testing.T) {
+			// --- Given ---
+			wr := NewWriter(
+				WithStatus("ECMapped", http.StatusConflict),
+				WithStatus("ECField", http.StatusTeapot),
+			)
+
+			// --- When ---
+			have := wr.Status(tc.err)
+
+			// --- Then ---
+			assert.Equal(t, tc.want, have)
+		})
+	}
+}
+
+func Test_Writer_WriteError(t *testing.T) {
+	t.Run("nil error", func(t *testing.T) {
+		// --- Given ---
+		var called bool
+		hook := func(*http.Request, int, error) { called = true }
+		wr := NewWriter(WithErrorHook(hook))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// --- When ---
+		wr.WriteError(w, r, nil)
+
+		// --- Then ---
+		assert.False(t, called)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "", w.Body.String())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		wr := NewWriter(WithStatus("ECode", http.StatusNotFound))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// --- When ---
+		wr.WriteError(w, r, xrr.New("not found", "ECode"))
+
+		// --- Then ---
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		wCT := "application/json; charset=utf-8"
+		assert.Equal(t, wCT, w.Header().Get("Content-Type"))
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+		assert.JSON(t, `{"error":"not found","code":"ECode"}`, w.Body.String())
+	})
+
+	t.Run("fields error", func(t *testing.T) {
+		// --- Given ---
+		wr := NewWriter()
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		err := xrr.NewFieldError("f", xrr.New("msg", "ECode"))
+
+		// --- When ---
+		wr.WriteError(w, r, err)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		want := `{
+			"error": "fields error",
+			"code": "ECFields",
+			"fields": {"f": {"error": "msg", "code": "ECode"}}
+		}`
+		assert.JSON(t, want, w.Body.String())
+	})
+
+	t.Run("hook is called", func(t *testing.T) {
+		// --- Given ---
+		var hStatus int
+		var hErr error
+		hook := func(_ *http.Request, status int, err error) {
+			hStatus, hErr = status, err
+		}
+		wr := NewWriter(WithErrorHook(hook))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		err := xrr.ErrInvJSON
+
+		// --- When ---
+		wr.WriteError(w, r, err)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusBadRequest, hStatus)
+		assert.Same(t, err, hErr)
+	})
+
+	t.Run("marshal error", func(t *testing.T) {
+		// --- Given ---
+		var hStatus int
+		var hErr error
+		hook := func(_ *http.Request, status int, err error) {
+			hStatus, hErr = status, err
+		}
+		wr := NewWriter(WithErrorHook(hook))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		err := xrr.Enclose(tErrMarshalJSON{}, xrr.ErrInvJSON)
+
+		// --- When ---
+		wr.WriteError(w, r, err)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		want := `{"error":"internal server error","code":"ECInternal"}`
+		assert.JSON(t, want, w.Body.String())
+		assert.Equal(t, http.StatusInternalServerError, hStatus)
+		assert.ErrorIs(t, xrr.ErrInvJSON, hErr)
+		assert.ErrorContain(t, "MarshalJSON", hErr)
+	})
+}
+
+func Test_Writer_Handler(t *testing.T) {
+	t.Run("no error", func(t *testing.T) {
+		// --- Given ---
+		fn := func(w http.ResponseWriter, r *http.Request) error {
+			w.WriteHeader(http.StatusNoContent)
+			return nil
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// --- When ---
+		NewWriter().Handler(fn).ServeHTTP(w, r)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "", w.Body.String())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		// --- Given ---
+		fn := func(w http.ResponseWriter, r *http.Request) error {
+			return xrr.ErrInvJSON
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// --- When ---
+		NewWriter().Handler(fn).ServeHTTP(w, r)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		want := `{"error":"invalid JSON","code":"ECInvJSON"}`
+		assert.JSON(t, want, w.Body.String())
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		// --- Given ---
+		var hStatus int
+		var hErr error
+		hook := func(_ *http.Request, status int, err error) {
+			hStatus, hErr = status, err
+		}
+		fn := func(w http.ResponseWriter, r *http.Request) error {
+			panic("secret")
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// --- When ---
+		NewWriter(WithErrorHook(hook)).Handler(fn).ServeHTTP(w, r)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		want := `{"error":"internal server error","code":"ECPanic"}`
+		assert.JSON(t, want, w.Body.String())
+		assert.Equal(t, http.StatusInternalServerError, hStatus)
+		assert.ErrorEqual(t, "internal server error: panic: secret", hErr)
+		assert.Equal(t, ECPanic, xrr.GetCode(hErr))
+		assert.NotNil(t, xrr.GetStack(hErr))
+	})
+
+	t.Run("panic with error", func(t *testing.T) {
+		// --- Given ---
+		var hErr error
+		hook := func(_ *http.Request, _ int, err error) { hErr = err }
+		fn := func(w http.ResponseWriter, r *http.Request) error {
+			panic(xrr.ErrInvJSON)
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// --- When ---
+		NewWriter(WithErrorHook(hook)).Handler(fn).ServeHTTP(w, r)
+
+		// --- Then ---
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		want := `{"error":"internal server error","code":"ECPanic"}`
+		assert.JSON(t, want, w.Body.String())
+		assert.ErrorEqual(t, "internal server error: panic: invalid JSON", hErr)
+		assert.ErrorIs(t, xrr.ErrInvJSON, hErr)
+		assert.Equal(t, ECPanic, xrr.GetCode(hErr))
+	})
+
+	t.Run("abort handler panic is not recovered", func(t *testing.T) {
+		// --- Given ---
+		fn := func(w http.ResponseWriter, r *http.Request) error {
+			panic(http.ErrAbortHandler)
+		}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		// --- When ---
+		var have any
+		func() {
+			defer func() { have = recover() }()
+			NewWriter().Handler(fn).ServeHTTP(w, r)
+		}()
+
+		// --- Then ---
+		assert.Same(t, http.ErrAbortHandler, have)
+	})
+}
+
+func Test_WriteError(t *testing.T) {
+	// --- Given ---
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	// --- When ---
+	WriteError(w, r, xrr.ErrFields)
+
+	// --- Then ---
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.JSON(t, `{"error":"fields error","code":"ECFields"}`, w.Body.String())
+}
+
+func Test_Handler(t *testing.T) {
+	// --- Given ---
+	fn := func(w http.ResponseWriter, r *http.Request) error {
+		panic("secret")
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	// --- When ---
+	Handler(fn).ServeHTTP(w, r)
+
+	// --- Then ---
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	want := `{"error":"internal server error","code":"ECPanic"}`
+	assert.JSON(t, want, w.Body.String())
+}
+
+func Test_Validate(t *testing.T) {
+	// --- When ---
+	err := xrr.Validate()
+
+	// --- Then ---
+	assert.NoError(t, err)
+}
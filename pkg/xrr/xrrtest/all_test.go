@@ -33,3 +33,16 @@ func TstError() error {
 
 	return e
 }
+
+// TstErrorExt returns a test error with metadata of unsigned integer, slice
+// and group types.
+func TstErrorExt() error {
+	m := xrr.Meta().Uint64("uint64", 1).Strs("strs", "a", "b").Option()
+	e := xrr.New("msg", "EC", m)
+
+	grp := xrr.Meta().Int("max", 10)
+	m = xrr.Meta().Ints("ints", 1, 2).Group("group", grp).Option()
+	e = xrr.WrapUsing[edXrrTest](e, m)
+
+	return e
+}
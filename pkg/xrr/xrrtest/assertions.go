@@ -85,6 +85,46 @@ func AssertCode(t tester.T, want string, err error) bool {
 	return true
 }
 
+// AssertCodeUnder asserts err is not nil and has an error in the chain (tree)
+// with the error code in the given category (see [xrr.IsCodeUnder]). Returns
+// true if it does, otherwise marks the test as failed, writes an error message
+// to the test log, and returns false.
+func AssertCodeUnder(t tester.T, category xrr.CodeCategory, err error) bool {
+	t.Helper()
+	if e := check.NotNil(err); e != nil {
+		t.Error(notice.From(e).SetHeader("[xrr] expected error not to be nil"))
+		return false
+	}
+	if !xrr.IsCodeUnder(err, category) {
+		msg := notice.New("[xrr] expected error with code in category").
+			Append("category", "%q", category).
+			Append("codes", "%q", xrr.GetCodes(err))
+		t.Error(msg)
+		return false
+	}
+	return true
+}
+
+// AssertCodeMatch asserts err is not nil and has an error in the chain (tree)
+// with the error code matching the pattern (see [xrr.CodeMatch]). Returns
+// true if it does, otherwise marks the test as failed, writes an error message
+// to the test log, and returns false.
+func AssertCodeMatch(t tester.T, pattern string, err error) bool {
+	t.Helper()
+	if e := check.NotNil(err); e != nil {
+		t.Error(notice.From(e).SetHeader("[xrr] expected error not to be nil"))
+		return false
+	}
+	if !xrr.IsCodeMatch(err, pattern) {
+		msg := notice.New("[xrr] expected error with code matching pattern").
+			Append("pattern", "%q", pattern).
+			Append("codes", "%q", xrr.GetCodes(err))
+		t.Error(msg)
+		return false
+	}
+	return true
+}
+
 // AssertKeyCnt asserts that the provided error is non-nil and error metadata,
 // retrieved using [xrr.GetMeta], has a given number of keys. Returns true if
 // it does, otherwise marks the test as failed, writes an error message to the
@@ -265,6 +305,66 @@ func AssertTime(t tester.T, key string, want time.Time, err error) bool {
 	return true
 }
 
+// AssertUint64 asserts that the provided error is non-nil and error metadata,
+// retrieved using [xrr.GetMeta], has the key with the given value. Returns
+// true if it has, otherwise marks the test as failed, writes an error message
+// to the test log, and returns false.
+func AssertUint64(t tester.T, key string, want uint64, err error) bool {
+	t.Helper()
+	return assertKey(t, key, want, err)
+}
+
+// AssertStrs asserts that the provided error is non-nil and error metadata,
+// retrieved using [xrr.GetMeta], has the key with the given value. Returns
+// true if it has, otherwise marks the test as failed, writes an error message
+// to the test log, and returns false.
+func AssertStrs(t tester.T, key string, want []string, err error) bool {
+	t.Helper()
+	return assertKey(t, key, want, err)
+}
+
+// AssertInts asserts that the provided error is non-nil and error metadata,
+// retrieved using [xrr.GetMeta], has the key with the given value. Returns
+// true if it has, otherwise marks the test as failed, writes an error message
+// to the test log, and returns false.
+func AssertInts(t tester.T, key string, want []int, err error) bool {
+	t.Helper()
+	return assertKey(t, key, want, err)
+}
+
+// AssertGroup asserts that the provided error is non-nil and error metadata,
+// retrieved using [xrr.GetMeta], has the key with the given value. Returns
+// true if it has, otherwise marks the test as failed, writes an error message
+// to the test log, and returns false.
+func AssertGroup(t tester.T, key string, want map[string]any, err error) bool {
+	t.Helper()
+	return assertKey(t, key, want, err)
+}
+
+// assertKey asserts that the provided error is non-nil and error metadata,
+// retrieved using [xrr.GetMeta], has the key with the given value. Returns
+// true if it has, otherwise marks the test as failed, writes an error message
+// to the test log, and returns false.
+func assertKey[V any](t tester.T, key string, want V, err error) bool {
+	t.Helper()
+	if e := check.NotNil(err); e != nil {
+		t.Error(notice.From(e).SetHeader("[xrr] expected error not to be nil"))
+		return false
+	}
+	have, e := check.HasKey(key, xrr.GetMeta(err))
+	if e != nil {
+		const hHeader = "[xrr] expected error to have the metadata key"
+		t.Error(notice.From(e).SetHeader(hHeader))
+		return false
+	}
+	if e = check.Equal(want, have); e != nil {
+		const hHeader = "[xrr] expected error metadata key"
+		t.Error(notice.From(e).SetHeader(hHeader))
+		return false
+	}
+	return true
+}
+
 // AssertFields asserts err is non-nil and implements [xrr.Fielder]. Returns
 // the [xrr.Fielder] instance and true on success. If err is nil or does not
 // implement [xrr.Fielder], it marks the test as failed, writes an error
@@ -212,6 +212,108 @@ func Test_AssertCode(t *testing.T) {
 	})
 }
 
+func Test_AssertCodeUnder(t *testing.T) {
+	t.Run("success - code in category", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := xrr.Wrap(xrr.New("msg", "DB.Conflict.Unique"), xrr.WithCode("ECode"))
+
+		// --- When ---
+		have := AssertCodeUnder(tspy, "DB.Conflict", err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - nil error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "[xrr] expected error not to be nil"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := AssertCodeUnder(tspy, "DB", nil)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - code not in category", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error with code in category:\n" +
+			"  category: \"DB\"\n" +
+			"     codes: [\"DBX.Timeout\"]"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := xrr.New("msg", "DBX.Timeout")
+
+		// --- When ---
+		have := AssertCodeUnder(tspy, "DB", err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_AssertCodeMatch(t *testing.T) {
+	t.Run("success - code matches", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := xrr.Wrap(xrr.New("msg", "DB.Conflict.Unique"), xrr.WithCode("ECode"))
+
+		// --- When ---
+		have := AssertCodeMatch(tspy, "**.Unique", err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - nil error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "[xrr] expected error not to be nil"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := AssertCodeMatch(tspy, "DB.*", nil)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - code does not match", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error with code matching pattern:\n" +
+			"  pattern: \"DB.*\"\n" +
+			"    codes: [\"DB.Conflict.Unique\"]"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := xrr.New("msg", "DB.Conflict.Unique")
+
+		// --- When ---
+		have := AssertCodeMatch(tspy, "DB.*", err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
 func Test_AssertKeyCnt(t *testing.T) {
 	t.Run("success - error has no metadata keys", func(t *testing.T) {
 		// --- Given ---
@@ -912,6 +1014,398 @@ func Test_AssertTime(t *testing.T) {
 	})
 }
 
+func Test_AssertUint64(t *testing.T) {
+	t.Run("success - error has the key value pair", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertUint64(tspy, "uint64", 1, err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("success - wrapped error has the key value pair", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := fmt.Errorf("w: %w", TstErrorExt())
+
+		// --- When ---
+		have := AssertUint64(tspy, "uint64", 1, err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - nil error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "[xrr] expected error not to be nil"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := AssertUint64(tspy, "uint64", 1, nil)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - key does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error to have the metadata key:\n" +
+			"  key: \"key\"\n" +
+			"  map:\n       map[string]any{\n" +
+			"         \"group\": {\n" +
+			"           \"max\": 10,\n" +
+			"         },\n" +
+			"         \"ints\": []int{\n" +
+			"           1,\n" +
+			"           2,\n" +
+			"         },\n" +
+			"         \"strs\": []string{\n" +
+			"           \"a\",\n" +
+			"           \"b\",\n" +
+			"         },\n" +
+			"         \"uint64\": 1,\n" +
+			"       }"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertUint64(tspy, "key", 1, err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - key is not of the uint64 type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error metadata key:\n" +
+			"  want type: uint64\n" +
+			"  have type: []string"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertUint64(tspy, "strs", 1, err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_AssertStrs(t *testing.T) {
+	t.Run("success - error has the key value pair", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertStrs(tspy, "strs", []string{"a", "b"}, err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("success - wrapped error has the key value pair", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := fmt.Errorf("w: %w", TstErrorExt())
+
+		// --- When ---
+		have := AssertStrs(tspy, "strs", []string{"a", "b"}, err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - nil error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "[xrr] expected error not to be nil"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := AssertStrs(tspy, "strs", []string{"a", "b"}, nil)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - key does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error to have the metadata key:\n" +
+			"  key: \"key\"\n" +
+			"  map:\n       map[string]any{\n" +
+			"         \"group\": {\n" +
+			"           \"max\": 10,\n" +
+			"         },\n" +
+			"         \"ints\": []int{\n" +
+			"           1,\n" +
+			"           2,\n" +
+			"         },\n" +
+			"         \"strs\": []string{\n" +
+			"           \"a\",\n" +
+			"           \"b\",\n" +
+			"         },\n" +
+			"         \"uint64\": 1,\n" +
+			"       }"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertStrs(tspy, "key", []string{"a", "b"}, err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - key is not of the []string type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error metadata key:\n" +
+			"  want type: []string\n" +
+			"  have type: uint64"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertStrs(tspy, "uint64", []string{"a", "b"}, err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_AssertInts(t *testing.T) {
+	t.Run("success - error has the key value pair", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertInts(tspy, "ints", []int{1, 2}, err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("success - wrapped error has the key value pair", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := fmt.Errorf("w: %w", TstErrorExt())
+
+		// --- When ---
+		have := AssertInts(tspy, "ints", []int{1, 2}, err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - nil error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "[xrr] expected error not to be nil"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := AssertInts(tspy, "ints", []int{1, 2}, nil)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - key does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error to have the metadata key:\n" +
+			"  key: \"key\"\n" +
+			"  map:\n       map[string]any{\n" +
+			"         \"group\": {\n" +
+			"           \"max\": 10,\n" +
+			"         },\n" +
+			"         \"ints\": []int{\n" +
+			"           1,\n" +
+			"           2,\n" +
+			"         },\n" +
+			"         \"strs\": []string{\n" +
+			"           \"a\",\n" +
+			"           \"b\",\n" +
+			"         },\n" +
+			"         \"uint64\": 1,\n" +
+			"       }"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertInts(tspy, "key", []int{1, 2}, err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - key is not of the []int type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error metadata key:\n" +
+			"  want type: []int\n" +
+			"  have type: uint64"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertInts(tspy, "uint64", []int{1, 2}, err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
+func Test_AssertGroup(t *testing.T) {
+	t.Run("success - error has the key value pair", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertGroup(tspy, "group", map[string]any{"max": 10}, err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("success - wrapped error has the key value pair", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.Close()
+
+		err := fmt.Errorf("w: %w", TstErrorExt())
+
+		// --- When ---
+		have := AssertGroup(tspy, "group", map[string]any{"max": 10}, err)
+
+		// --- Then ---
+		assert.True(t, have)
+	})
+
+	t.Run("error - nil error", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "[xrr] expected error not to be nil"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		// --- When ---
+		have := AssertGroup(tspy, "group", map[string]any{"max": 10}, nil)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - key does not exist", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error to have the metadata key:\n" +
+			"  key: \"key\"\n" +
+			"  map:\n       map[string]any{\n" +
+			"         \"group\": {\n" +
+			"           \"max\": 10,\n" +
+			"         },\n" +
+			"         \"ints\": []int{\n" +
+			"           1,\n" +
+			"           2,\n" +
+			"         },\n" +
+			"         \"strs\": []string{\n" +
+			"           \"a\",\n" +
+			"           \"b\",\n" +
+			"         },\n" +
+			"         \"uint64\": 1,\n" +
+			"       }"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertGroup(tspy, "key", map[string]any{"max": 10}, err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+
+	t.Run("error - key is not of the map type", func(t *testing.T) {
+		// --- Given ---
+		tspy := tester.New(t)
+		tspy.ExpectError()
+		wMsg := "" +
+			"[xrr] expected error metadata key:\n" +
+			"  want type: map[string]interface {}\n" +
+			"  have type: uint64"
+		tspy.ExpectLogEqual(wMsg)
+		tspy.Close()
+
+		err := TstErrorExt()
+
+		// --- When ---
+		have := AssertGroup(tspy, "uint64", map[string]any{"max": 10}, err)
+
+		// --- Then ---
+		assert.False(t, have)
+	})
+}
+
 func Test_AssertFields(t *testing.T) {
 	t.Run("success - error is an instance of xrr.Fielder", func(t *testing.T) {
 		// --- Given ---